@@ -36,6 +36,10 @@ type LogOnDetails struct {
 	// true if you want to get a login key which can be used in lieu of
 	// a password for subsequent logins. false or omitted otherwise.
 	ShouldRememberPassword bool
+
+	// Set to 2 to receive friend messages through the FriendMessages service,
+	// see Social.SendFriendMessage. Zero uses the legacy friend chat.
+	ChatMode uint32
 }
 
 // Log on with the given details. You must always specify username and
@@ -75,6 +79,9 @@ func (a *Auth) LogOn(details *LogOnDetails) {
 	if details.ShouldRememberPassword {
 		logon.ShouldRememberPassword = proto.Bool(details.ShouldRememberPassword)
 	}
+	if details.ChatMode != 0 {
+		logon.ChatMode = proto.Uint32(details.ChatMode)
+	}
 
 	atomic.StoreUint64(&a.client.steamId, uint64(steamid.NewIdAdv(0, 1, int32(steamlang.EUniverse_Public), int32(steamlang.EAccountType_Individual))))
 
@@ -0,0 +1,530 @@
+package steam
+
+import (
+	"math"
+	"time"
+
+	"github.com/vuquang23/go-steam/protocol"
+	"github.com/vuquang23/go-steam/protocol/protobuf/unified"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/socialcache"
+	"github.com/vuquang23/go-steam/steamid"
+	"google.golang.org/protobuf/proto"
+)
+
+const chatInviteUrl = "https://s.team/chat/"
+
+// Provides access to Steam's group chats through the ChatRoom service. A group
+// has one or more chat rooms (channels), which are identified by the group id
+// and their chat id.
+//
+// Notifications are only received when logged on with LogOnDetails.ChatMode set to 2.
+// Call GetGroups after logging on to fill Groups, it's kept up to date afterwards.
+type ChatRoom struct {
+	Groups *socialcache.ChatRoomGroupsList
+
+	client *Client
+}
+
+func newChatRoom(client *Client) *ChatRoom {
+	return &ChatRoom{
+		Groups: socialcache.NewChatRoomGroupsList(),
+		client: client,
+	}
+}
+
+// Requests the groups we are in. A ChatRoomGroupsEvent is fired in response.
+func (c *ChatRoom) GetGroups() protocol.JobId {
+	return c.client.callServiceMethod("ChatRoom.GetMyChatRoomGroups#1", new(unified.CChatRoom_GetMyChatRoomGroups_Request), func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_GetMyChatRoomGroups_Response)
+		msg := packet.ReadProtoMsg(body)
+		var groups []socialcache.ChatRoomGroup
+		for _, pair := range body.GetChatRoomGroups() {
+			group := c.groupFromSummary(pair.GetGroupSummary())
+			c.Groups.Add(group)
+			groups = append(groups, group)
+		}
+		c.client.Emit(&ChatRoomGroupsEvent{
+			JobId:  packet.TargetJobId,
+			Result: serviceMethodResult(msg),
+			Groups: groups,
+		})
+	})
+}
+
+// Requests a group together with its members. A ChatRoomGroupStateEvent is fired in response.
+func (c *ChatRoom) GetGroupState(groupId uint64) protocol.JobId {
+	return c.client.callServiceMethod("ChatRoom.GetChatRoomGroupState#1", &unified.CChatRoom_GetChatRoomGroupState_Request{
+		ChatGroupId: proto.Uint64(groupId),
+	}, func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_GetChatRoomGroupState_Response)
+		msg := packet.ReadProtoMsg(body)
+		result := serviceMethodResult(msg)
+		state := body.GetState()
+		group := socialcache.ChatRoomGroup{
+			Id:                  groupId,
+			ChatRoomGroupHeader: c.headerFromState(state.GetHeaderState()),
+			DefaultChatId:       state.GetDefaultChatId(),
+			ChatRooms:           channelsFromStates(state.GetChatRooms()),
+			Members:             make(map[steamid.SteamId]socialcache.ChatRoomMember),
+		}
+		for _, m := range state.GetMembers() {
+			member := c.memberFromState(m)
+			group.Members[member.SteamId] = member
+		}
+		if result == steamlang.EResult_OK {
+			if old, err := c.Groups.ById(groupId); err == nil {
+				group.Rank = old.Rank
+				c.Groups.Add(group)
+			}
+		}
+		c.client.Emit(&ChatRoomGroupStateEvent{
+			JobId:  packet.TargetJobId,
+			Result: result,
+			Group:  group,
+		})
+	})
+}
+
+// Joins a group, inviteCode is only needed for groups we weren't invited to.
+// A ChatRoomJoinedEvent is fired in response.
+func (c *ChatRoom) JoinGroup(groupId uint64, inviteCode string) protocol.JobId {
+	req := &unified.CChatRoom_JoinChatRoomGroup_Request{
+		ChatGroupId: proto.Uint64(groupId),
+	}
+	if inviteCode != "" {
+		req.InviteCode = proto.String(inviteCode)
+	}
+	return c.client.callServiceMethod("ChatRoom.JoinChatRoomGroup#1", req, func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_JoinChatRoomGroup_Response)
+		msg := packet.ReadProtoMsg(body)
+		result := serviceMethodResult(msg)
+		group := c.groupFromSummary(body.GetState())
+		if result == steamlang.EResult_OK {
+			c.Groups.Add(group)
+		}
+		c.client.Emit(&ChatRoomJoinedEvent{
+			JobId:  packet.TargetJobId,
+			Result: result,
+			Group:  group,
+			ChatId: body.GetJoinChatId(),
+		})
+	})
+}
+
+// Leaves a group. A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) LeaveGroup(groupId uint64) protocol.JobId {
+	return c.call("ChatRoom.LeaveChatRoomGroup#1", groupId, &unified.CChatRoom_LeaveChatRoomGroup_Request{
+		ChatGroupId: proto.Uint64(groupId),
+	}, func(result steamlang.EResult) {
+		if result == steamlang.EResult_OK {
+			c.Groups.Remove(groupId)
+		}
+	})
+}
+
+// Sends a message to a chat room of a group. BBCode like [mention=accountid]@name[/mention]
+// is supported. A ChatRoomMessageSentEvent is fired in response.
+func (c *ChatRoom) SendMessage(groupId uint64, chatId uint64, message string) protocol.JobId {
+	return c.client.callServiceMethod("ChatRoom.SendChatMessage#1", &unified.CChatRoom_SendChatMessage_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		ChatId:      proto.Uint64(chatId),
+		Message:     proto.String(message),
+	}, func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_SendChatMessage_Response)
+		msg := packet.ReadProtoMsg(body)
+		c.client.Emit(&ChatRoomMessageSentEvent{
+			JobId:           packet.TargetJobId,
+			Result:          serviceMethodResult(msg),
+			GroupId:         groupId,
+			ChatId:          chatId,
+			Message:         body.GetModifiedMessage(),
+			MessageNoBBCode: body.GetMessageWithoutBbCode(),
+			Timestamp:       time.Unix(int64(body.GetServerTimestamp()), 0),
+			Ordinal:         body.GetOrdinal(),
+		})
+	})
+}
+
+// Marks the messages of a chat room up to the given time as read
+func (c *ChatRoom) AckMessage(groupId uint64, chatId uint64, timestamp time.Time) {
+	c.client.notifyServiceMethod("ChatRoom.AckChatMessage#1", &unified.CChatRoom_AckChatMessage_Notification{
+		ChatGroupId: proto.Uint64(groupId),
+		ChatId:      proto.Uint64(chatId),
+		Timestamp:   proto.Uint32(uint32(timestamp.Unix())),
+	})
+}
+
+// Kicks a member from a group, who can't join again until the given time.
+// The zero time lets them join again right away, like the Steam client does.
+// Steam stores the time in 32 bits, later times are capped at 2038.
+// A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) KickMember(groupId uint64, member steamid.SteamId, until time.Time) protocol.JobId {
+	if until.IsZero() {
+		until = time.Now()
+	}
+	expiration := until.Unix()
+	if expiration > math.MaxInt32 {
+		expiration = math.MaxInt32
+	}
+	return c.call("ChatRoom.KickUserFromGroup#1", groupId, &unified.CChatRoom_KickUser_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		Steamid:     proto.Uint64(member.ToUint64()),
+		Expiration:  proto.Int32(int32(expiration)),
+	}, nil)
+}
+
+// Bans or unbans a user from a group. A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) SetBanned(groupId uint64, user steamid.SteamId, banned bool) protocol.JobId {
+	return c.call("ChatRoom.SetUserBanState#1", groupId, &unified.CChatRoom_SetUserBanState_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		Steamid:     proto.Uint64(user.ToUint64()),
+		BanState:    proto.Bool(banned),
+	}, nil)
+}
+
+// Requests the users banned from a group. A ChatRoomBanListEvent is fired in response.
+func (c *ChatRoom) GetBanList(groupId uint64) protocol.JobId {
+	return c.client.callServiceMethod("ChatRoom.GetBanList#1", &unified.CChatRoom_GetBanList_Request{
+		ChatGroupId: proto.Uint64(groupId),
+	}, func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_GetBanList_Response)
+		msg := packet.ReadProtoMsg(body)
+		var bans []ChatRoomBan
+		for _, ban := range body.GetBans() {
+			bans = append(bans, ChatRoomBan{
+				SteamId: c.client.accountSteamId(ban.GetAccountid(), steamlang.EAccountType_Individual),
+				ActorId: c.client.accountSteamId(ban.GetAccountidActor(), steamlang.EAccountType_Individual),
+				Time:    time.Unix(int64(ban.GetTimeBanned()), 0),
+				Reason:  ban.GetBanReason(),
+			})
+		}
+		c.client.Emit(&ChatRoomBanListEvent{
+			JobId:   packet.TargetJobId,
+			Result:  serviceMethodResult(msg),
+			GroupId: groupId,
+			Bans:    bans,
+		})
+	})
+}
+
+// Requests the roles of a group. A ChatRoomRolesEvent is fired in response.
+func (c *ChatRoom) GetRoles(groupId uint64) protocol.JobId {
+	return c.client.callServiceMethod("ChatRoom.GetRoles#1", &unified.CChatRoom_GetRoles_Request{
+		ChatGroupId: proto.Uint64(groupId),
+	}, func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_GetRoles_Response)
+		msg := packet.ReadProtoMsg(body)
+		c.client.Emit(&ChatRoomRolesEvent{
+			JobId:   packet.TargetJobId,
+			Result:  serviceMethodResult(msg),
+			GroupId: groupId,
+			Roles:   rolesFromProto(body.GetRoles()),
+		})
+	})
+}
+
+// Creates a role in a group. A ChatRoomRoleCreatedEvent is fired in response.
+func (c *ChatRoom) CreateRole(groupId uint64, name string) protocol.JobId {
+	return c.client.callServiceMethod("ChatRoom.CreateRole#1", &unified.CChatRoom_CreateRole_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		Name:        proto.String(name),
+	}, func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_CreateRole_Response)
+		msg := packet.ReadProtoMsg(body)
+		c.client.Emit(&ChatRoomRoleCreatedEvent{
+			JobId:   packet.TargetJobId,
+			Result:  serviceMethodResult(msg),
+			GroupId: groupId,
+			RoleId:  body.GetActions().GetRoleId(),
+		})
+	})
+}
+
+// Deletes a role of a group. A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) DeleteRole(groupId uint64, roleId uint64) protocol.JobId {
+	return c.call("ChatRoom.DeleteRole#1", groupId, &unified.CChatRoom_DeleteRole_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		RoleId:      proto.Uint64(roleId),
+	}, nil)
+}
+
+// Renames a role of a group. A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) RenameRole(groupId uint64, roleId uint64, name string) protocol.JobId {
+	return c.call("ChatRoom.RenameRole#1", groupId, &unified.CChatRoom_RenameRole_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		RoleId:      proto.Uint64(roleId),
+		Name:        proto.String(name),
+	}, nil)
+}
+
+// Gives a member a role. A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) AddRoleToMember(groupId uint64, roleId uint64, member steamid.SteamId) protocol.JobId {
+	return c.call("ChatRoom.AddRoleToUser#1", groupId, &unified.CChatRoom_AddRoleToUser_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		RoleId:      proto.Uint64(roleId),
+		Steamid:     proto.Uint64(member.ToUint64()),
+	}, nil)
+}
+
+// Takes a role from a member. A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) RemoveRoleFromMember(groupId uint64, roleId uint64, member steamid.SteamId) protocol.JobId {
+	return c.call("ChatRoom.DeleteRoleFromUser#1", groupId, &unified.CChatRoom_DeleteRoleFromUser_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		RoleId:      proto.Uint64(roleId),
+		Steamid:     proto.Uint64(member.ToUint64()),
+	}, nil)
+}
+
+// Creates an invite link to a group, which opens the given chat room if chatId
+// isn't zero. A zero valid duration creates a link that never expires.
+// A ChatRoomInviteLinkEvent is fired in response.
+func (c *ChatRoom) CreateInviteLink(groupId uint64, chatId uint64, valid time.Duration) protocol.JobId {
+	req := &unified.CChatRoom_CreateInviteLink_Request{
+		ChatGroupId: proto.Uint64(groupId),
+	}
+	if chatId != 0 {
+		req.ChatId = proto.Uint64(chatId)
+	}
+	if valid > 0 {
+		req.SecondsValid = proto.Uint32(uint32(valid / time.Second))
+	}
+	return c.client.callServiceMethod("ChatRoom.CreateInviteLink#1", req, func(packet *protocol.Packet) {
+		body := new(unified.CChatRoom_CreateInviteLink_Response)
+		msg := packet.ReadProtoMsg(body)
+		event := &ChatRoomInviteLinkEvent{
+			JobId:   packet.TargetJobId,
+			Result:  serviceMethodResult(msg),
+			GroupId: groupId,
+			Code:    body.GetInviteCode(),
+		}
+		if event.Code != "" {
+			event.Url = chatInviteUrl + event.Code
+		}
+		if seconds := body.GetSecondsValid(); seconds > 0 {
+			event.Expires = time.Now().Add(time.Duration(seconds) * time.Second)
+		}
+		c.client.Emit(event)
+	})
+}
+
+// Revokes an invite link. A ChatRoomResultEvent is fired in response.
+func (c *ChatRoom) DeleteInviteLink(groupId uint64, code string) protocol.JobId {
+	return c.call("ChatRoom.DeleteInviteLink#1", groupId, &unified.CChatRoom_DeleteInviteLink_Request{
+		ChatGroupId: proto.Uint64(groupId),
+		InviteCode:  proto.String(code),
+	}, nil)
+}
+
+// Calls a method whose response has no fields and fires a ChatRoomResultEvent.
+// done may be nil, it's called before the event is fired.
+func (c *ChatRoom) call(method string, groupId uint64, body proto.Message, done func(result steamlang.EResult)) protocol.JobId {
+	return c.client.callServiceMethod(method, body, func(packet *protocol.Packet) {
+		result := serviceMethodResult(packet.ReadProtoMsg(new(unified.NoResponse)))
+		if done != nil {
+			done(result)
+		}
+		c.client.Emit(&ChatRoomResultEvent{
+			JobId:   packet.TargetJobId,
+			Result:  result,
+			GroupId: groupId,
+		})
+	})
+}
+
+func (c *ChatRoom) HandlePacket(packet *protocol.Packet) {
+	if packet.EMsg != steamlang.EMsg_ServiceMethod {
+		return
+	}
+	switch serviceMethodName(packet) {
+	case "ChatRoomClient.NotifyIncomingChatMessage#1":
+		c.handleIncomingChatMessage(packet)
+	case "ChatRoomClient.NotifyMemberStateChange#1":
+		c.handleMemberStateChange(packet)
+	case "ChatRoomClient.NotifyChatGroupUserStateChanged#1":
+		c.handleGroupUserStateChanged(packet)
+	case "ChatRoomClient.NotifyChatRoomHeaderStateChange#1":
+		c.handleHeaderStateChange(packet)
+	case "ChatRoomClient.NotifyChatRoomGroupRoomsChange#1":
+		c.handleGroupRoomsChange(packet)
+	}
+}
+
+func (c *ChatRoom) handleIncomingChatMessage(packet *protocol.Packet) {
+	body := new(unified.CChatRoom_IncomingChatMessage_Notification)
+	packet.ReadProtoMsg(body)
+	event := &ChatRoomMessageEvent{
+		GroupId:         body.GetChatGroupId(),
+		ChatId:          body.GetChatId(),
+		ChatName:        body.GetChatName(),
+		SenderId:        steamid.SteamId(body.GetSteamidSender()),
+		Message:         body.GetMessage(),
+		MessageNoBBCode: body.GetMessageNoBbcode(),
+		Timestamp:       time.Unix(int64(body.GetTimestamp()), 0),
+		Ordinal:         body.GetOrdinal(),
+		MentionAll:      body.GetMentions().GetMentionAll(),
+		MentionHere:     body.GetMentions().GetMentionHere(),
+	}
+	for _, accountId := range body.GetMentions().GetMentionAccountids() {
+		event.Mentions = append(event.Mentions, c.client.accountSteamId(accountId, steamlang.EAccountType_Individual))
+	}
+	if server := body.GetServerMessage(); server != nil {
+		event.ServerMessage = &ChatRoomServerMessage{
+			Type:        server.GetMessage(),
+			StringParam: server.GetStringParam(),
+		}
+		if server.GetAccountidParam() != 0 {
+			event.ServerMessage.SteamId = c.client.accountSteamId(server.GetAccountidParam(), steamlang.EAccountType_Individual)
+		}
+	}
+	c.client.Emit(event)
+}
+
+func (c *ChatRoom) handleMemberStateChange(packet *protocol.Packet) {
+	body := new(unified.CChatRoom_MemberStateChange_Notification)
+	packet.ReadProtoMsg(body)
+	groupId := body.GetChatGroupId()
+	member := c.memberFromState(body.GetMember())
+	change := body.GetChange()
+	switch change {
+	case unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Joined,
+		unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_RankChanged,
+		unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_RolesChanged,
+		unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Muted:
+		c.Groups.AddMember(groupId, member)
+	case unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Parted,
+		unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Kicked,
+		unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Banned:
+		c.Groups.RemoveMember(groupId, member.SteamId)
+	}
+	c.client.Emit(&ChatRoomMemberStateEvent{
+		GroupId: groupId,
+		Member:  member,
+		Change:  change,
+	})
+}
+
+func (c *ChatRoom) handleGroupUserStateChanged(packet *protocol.Packet) {
+	body := new(unified.CChatRoomClient_NotifyChatGroupUserStateChanged_Notification)
+	packet.ReadProtoMsg(body)
+	groupId := body.GetChatGroupId()
+	action := body.GetUserAction()
+	switch action {
+	case unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Joined:
+		if body.GetGroupSummary() != nil {
+			c.Groups.Add(c.groupFromSummary(body.GetGroupSummary()))
+		}
+	case unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Parted,
+		unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Kicked,
+		unified.EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Banned:
+		c.Groups.Remove(groupId)
+	}
+	c.client.Emit(&ChatRoomGroupUserStateEvent{
+		GroupId: groupId,
+		Action:  action,
+	})
+}
+
+func (c *ChatRoom) handleHeaderStateChange(packet *protocol.Packet) {
+	body := new(unified.CChatRoom_ChatRoomHeaderState_Notification)
+	packet.ReadProtoMsg(body)
+	groupId := body.GetHeaderState().GetChatGroupId()
+	header := c.headerFromState(body.GetHeaderState())
+	c.Groups.SetHeader(groupId, header)
+	c.client.Emit(&ChatRoomGroupHeaderEvent{
+		GroupId: groupId,
+		Header:  header,
+	})
+}
+
+func (c *ChatRoom) handleGroupRoomsChange(packet *protocol.Packet) {
+	body := new(unified.CChatRoom_ChatRoomGroupRoomsChange_Notification)
+	packet.ReadProtoMsg(body)
+	rooms := channelsFromStates(body.GetChatRooms())
+	c.Groups.SetChatRooms(body.GetChatGroupId(), body.GetDefaultChatId(), rooms)
+	c.client.Emit(&ChatRoomChannelsEvent{
+		GroupId:       body.GetChatGroupId(),
+		DefaultChatId: body.GetDefaultChatId(),
+		ChatRooms:     rooms,
+	})
+}
+
+func (c *ChatRoom) groupFromSummary(summary *unified.CChatRoomGroupSummaryResponse) socialcache.ChatRoomGroup {
+	group := socialcache.ChatRoomGroup{
+		Id: summary.GetChatGroupId(),
+		ChatRoomGroupHeader: socialcache.ChatRoomGroupHeader{
+			Name:          summary.GetChatGroupName(),
+			Tagline:       summary.GetChatGroupTagline(),
+			Avatar:        summary.GetChatGroupAvatarSha(),
+			AppId:         summary.GetAppid(),
+			DefaultRoleId: summary.GetDefaultRoleId(),
+		},
+		DefaultChatId: summary.GetDefaultChatId(),
+		ChatRooms:     channelsFromStates(summary.GetChatRooms()),
+		Rank:          summary.GetRank(),
+	}
+	if summary.GetClanid() != 0 {
+		group.ClanId = c.client.accountSteamId(summary.GetClanid(), steamlang.EAccountType_Clan)
+	}
+	if summary.GetAccountidOwner() != 0 {
+		group.OwnerId = c.client.accountSteamId(summary.GetAccountidOwner(), steamlang.EAccountType_Individual)
+	}
+	return group
+}
+
+func (c *ChatRoom) headerFromState(state *unified.CChatRoomGroupHeaderState) socialcache.ChatRoomGroupHeader {
+	header := socialcache.ChatRoomGroupHeader{
+		Name:          state.GetChatName(),
+		Tagline:       state.GetTagline(),
+		Avatar:        state.GetAvatarSha(),
+		AppId:         state.GetAppid(),
+		DefaultRoleId: state.GetDefaultRoleId(),
+		Roles:         rolesFromProto(state.GetRoles()),
+	}
+	if state.GetClanid() != 0 {
+		header.ClanId = c.client.accountSteamId(state.GetClanid(), steamlang.EAccountType_Clan)
+	}
+	if state.GetAccountidOwner() != 0 {
+		header.OwnerId = c.client.accountSteamId(state.GetAccountidOwner(), steamlang.EAccountType_Individual)
+	}
+	return header
+}
+
+func (c *ChatRoom) memberFromState(state *unified.CChatRoomMember) socialcache.ChatRoomMember {
+	member := socialcache.ChatRoomMember{
+		SteamId: c.client.accountSteamId(state.GetAccountid(), steamlang.EAccountType_Individual),
+		State:   state.GetState(),
+		Rank:    state.GetRank(),
+		RoleIds: state.GetRoleIds(),
+	}
+	if state.GetTimeKickExpire() != 0 {
+		member.KickExpires = time.Unix(int64(state.GetTimeKickExpire()), 0)
+	}
+	return member
+}
+
+func channelsFromStates(states []*unified.CChatRoomState) []socialcache.ChatRoomChannel {
+	var rooms []socialcache.ChatRoomChannel
+	for _, state := range states {
+		rooms = append(rooms, socialcache.ChatRoomChannel{
+			Id:           state.GetChatId(),
+			Name:         state.GetChatName(),
+			VoiceAllowed: state.GetVoiceAllowed(),
+			LastMessage:  time.Unix(int64(state.GetTimeLastMessage()), 0),
+			SortOrder:    state.GetSortOrder(),
+		})
+	}
+	return rooms
+}
+
+func rolesFromProto(roles []*unified.CChatRole) []socialcache.ChatRole {
+	var result []socialcache.ChatRole
+	for _, role := range roles {
+		result = append(result, socialcache.ChatRole{
+			Id:      role.GetRoleId(),
+			Name:    role.GetName(),
+			Ordinal: role.GetOrdinal(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package steam
+
+import (
+	"time"
+
+	"github.com/vuquang23/go-steam/protocol"
+	"github.com/vuquang23/go-steam/protocol/protobuf/unified"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/socialcache"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Fired in response to GetGroups
+type ChatRoomGroupsEvent struct {
+	JobId  protocol.JobId
+	Result steamlang.EResult
+	Groups []socialcache.ChatRoomGroup
+}
+
+// Fired in response to GetGroupState, Group includes the members
+type ChatRoomGroupStateEvent struct {
+	JobId  protocol.JobId
+	Result steamlang.EResult
+	Group  socialcache.ChatRoomGroup
+}
+
+// Fired in response to JoinGroup
+type ChatRoomJoinedEvent struct {
+	JobId  protocol.JobId
+	Result steamlang.EResult
+	Group  socialcache.ChatRoomGroup
+	ChatId uint64 `json:",string"` // the chat room we joined
+}
+
+// Fired in response to requests that only have a result, like LeaveGroup,
+// KickMember, SetBanned, the role changes and DeleteInviteLink
+type ChatRoomResultEvent struct {
+	JobId   protocol.JobId
+	Result  steamlang.EResult
+	GroupId uint64 `json:",string"`
+}
+
+// Fired in response to SendMessage
+type ChatRoomMessageSentEvent struct {
+	JobId           protocol.JobId
+	Result          steamlang.EResult
+	GroupId         uint64 `json:",string"`
+	ChatId          uint64 `json:",string"`
+	Message         string // the message as Steam modified it
+	MessageNoBBCode string
+	Timestamp       time.Time
+	Ordinal         uint32
+}
+
+// Fired in response to CreateInviteLink
+type ChatRoomInviteLinkEvent struct {
+	JobId   protocol.JobId
+	Result  steamlang.EResult
+	GroupId uint64 `json:",string"`
+	Code    string
+	Url     string
+	Expires time.Time // zero if the link never expires
+}
+
+// Fired in response to GetBanList
+type ChatRoomBanListEvent struct {
+	JobId   protocol.JobId
+	Result  steamlang.EResult
+	GroupId uint64 `json:",string"`
+	Bans    []ChatRoomBan
+}
+
+type ChatRoomBan struct {
+	SteamId steamid.SteamId `json:",string"`
+	ActorId steamid.SteamId `json:",string"` // who banned the user
+	Time    time.Time
+	Reason  string
+}
+
+// Fired in response to GetRoles
+type ChatRoomRolesEvent struct {
+	JobId   protocol.JobId
+	Result  steamlang.EResult
+	GroupId uint64 `json:",string"`
+	Roles   []socialcache.ChatRole
+}
+
+// Fired in response to CreateRole
+type ChatRoomRoleCreatedEvent struct {
+	JobId   protocol.JobId
+	Result  steamlang.EResult
+	GroupId uint64 `json:",string"`
+	RoleId  uint64 `json:",string"`
+}
+
+// Fired when a message is sent to a chat room of one of our groups
+type ChatRoomMessageEvent struct {
+	GroupId         uint64 `json:",string"`
+	ChatId          uint64 `json:",string"`
+	ChatName        string
+	SenderId        steamid.SteamId `json:",string"`
+	Message         string          // may contain BBCode
+	MessageNoBBCode string
+	Timestamp       time.Time
+	Ordinal         uint32
+	MentionAll      bool
+	MentionHere     bool
+	Mentions        []steamid.SteamId
+	// Set for messages of Steam, like a member that joined
+	ServerMessage *ChatRoomServerMessage
+}
+
+type ChatRoomServerMessage struct {
+	Type        unified.EChatRoomServerMessage
+	StringParam string
+	SteamId     steamid.SteamId `json:",string"`
+}
+
+// Fired when a member of one of our groups joined, left or was kicked,
+// banned or given other roles
+type ChatRoomMemberStateEvent struct {
+	GroupId uint64 `json:",string"`
+	Member  socialcache.ChatRoomMember
+	Change  unified.EChatRoomMemberStateChange
+}
+
+// Fired when we joined or left a group, possibly from another session
+type ChatRoomGroupUserStateEvent struct {
+	GroupId uint64 `json:",string"`
+	Action  unified.EChatRoomMemberStateChange
+}
+
+// Fired when the name, tagline, avatar or roles of a group changed
+type ChatRoomGroupHeaderEvent struct {
+	GroupId uint64 `json:",string"`
+	Header  socialcache.ChatRoomGroupHeader
+}
+
+// Fired when chat rooms of a group were created, renamed or deleted
+type ChatRoomChannelsEvent struct {
+	GroupId       uint64 `json:",string"`
+	DefaultChatId uint64 `json:",string"`
+	ChatRooms     []socialcache.ChatRoomChannel
+}
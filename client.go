@@ -36,6 +36,8 @@ type Client struct {
 
 	Auth          *Auth
 	Social        *Social
+	ChatRoom      *ChatRoom
+	RichPresence  *RichPresence
 	Web           *Web
 	Notifications *Notifications
 	Trading       *Trading
@@ -45,6 +47,10 @@ type Client struct {
 	handlers      []PacketHandler
 	handlersMutex sync.RWMutex
 
+	// handlers of pending jobs, see writeJob
+	jobs      map[protocol.JobId]func(*protocol.Packet)
+	jobsMutex sync.Mutex
+
 	tempSessionKey []byte
 
 	ConnectionTimeout time.Duration
@@ -72,6 +78,12 @@ func NewClient() *Client {
 	client.Social = newSocial(client)
 	client.RegisterPacketHandler(client.Social)
 
+	client.ChatRoom = newChatRoom(client)
+	client.RegisterPacketHandler(client.ChatRoom)
+
+	client.RichPresence = newRichPresence(client)
+	client.RegisterPacketHandler(client.RichPresence)
+
 	client.Web = &Web{client: client}
 	client.RegisterPacketHandler(client.Web)
 
@@ -195,6 +207,8 @@ func (c *Client) Disconnect() {
 		c.heartbeat.Stop()
 	}
 	close(c.writeChan)
+	c.clearJobs()
+	c.Social.cancelOfficerLookups()
 	c.Emit(&DisconnectedEvent{})
 
 }
@@ -292,6 +306,8 @@ func (c *Client) handlePacket(packet *protocol.Packet) {
 		c.handleMulti(packet)
 	case steamlang.EMsg_ClientCMList:
 		c.handleClientCMList(packet)
+	default:
+		c.handleJobResponse(packet)
 	}
 
 	c.handlersMutex.RLock()
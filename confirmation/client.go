@@ -9,7 +9,6 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -127,41 +126,52 @@ func (c *Client) GetConfirmations() ([]*Confirmation, error) {
 }
 
 func (c *Client) GetOfferID(conf *Confirmation) (uint64, error) {
-	req := fmt.Sprintf("%s/%s", "detailspage", conf.ID)
-	key, err := c.generateConfirmationCode(tradeInfoTag)
-	if err != nil {
-		return 0, err
-	}
-	resBytes, err := c.call(req, key, tradeInfoTag, nil)
+	doc, err := c.getDetailsPage(conf)
 	if err != nil {
 		return 0, err
 	}
+	return parseOfferID(doc)
+}
 
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(resBytes))
-	if err != nil {
-		return 0, err
+// Details fetches the details page of a confirmation and parses it according
+// to the confirmation type. Only trades and market listings carry type specific
+// data, for other types just the Type field is set.
+func (c *Client) Details(conf *Confirmation) (*Details, error) {
+	details := &Details{Type: conf.Type}
+	switch conf.Type {
+	case ConfirmationTypeTrade, ConfirmationTypeMarketListing:
+	default:
+		return details, nil
 	}
 
-	offer := doc.Find(".tradeoffer")
-	if offer == nil {
-		return 0, ErrCannotFindOffer
+	doc, err := c.getDetailsPage(conf)
+	if err != nil {
+		return nil, err
 	}
 
-	value, ok := offer.Attr("id")
-	if !ok {
-		return 0, ErrCannotFindOffer
+	switch conf.Type {
+	case ConfirmationTypeTrade:
+		details.Trade, err = parseTradeDetails(doc)
+	case ConfirmationTypeMarketListing:
+		details.MarketListing, err = parseMarketListingDetails(conf, doc)
 	}
-	strs := strings.Split(value, "_")
-	if len(strs) < 2 {
-		return 0, ErrCannotFindOffer
+	if err != nil {
+		return nil, err
 	}
+	return details, nil
+}
 
-	offerID, err := strconv.ParseUint(strs[1], 10, 64)
+func (c *Client) getDetailsPage(conf *Confirmation) (*goquery.Document, error) {
+	req := fmt.Sprintf("%s/%s", "detailspage", conf.ID)
+	key, err := c.generateConfirmationCode(tradeInfoTag)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-
-	return offerID, nil
+	resBytes, err := c.call(req, key, tradeInfoTag, nil)
+	if err != nil {
+		return nil, err
+	}
+	return goquery.NewDocumentFromReader(bytes.NewReader(resBytes))
 }
 
 func (c *Client) AcceptConfirmation(conf *Confirmation) error {
@@ -0,0 +1,159 @@
+package confirmation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+var (
+	// amounts may have spaces between thousands, like "1 234,56 pуб.", and
+	// end with "--" instead of zero decimals, like "20,--€"
+	listingReceiveRegex   = regexp.MustCompile(`(?i)you receive:?\s*([^\d\n]*\d[\d.,\x{00a0} ]*(?:--)?)`)
+	listingBuyerPaysRegex = regexp.MustCompile(`(?i)buyer pays:?\s*([^\d\n]*\d[\d.,\x{00a0} ]*(?:--)?)`)
+)
+
+func parseOfferID(doc *goquery.Document) (uint64, error) {
+	value, ok := doc.Find(".tradeoffer").Attr("id")
+	if !ok {
+		return 0, ErrCannotFindOffer
+	}
+	strs := strings.Split(value, "_")
+	if len(strs) < 2 {
+		return 0, ErrCannotFindOffer
+	}
+	return strconv.ParseUint(strs[1], 10, 64)
+}
+
+func parseTradeDetails(doc *goquery.Document) (*TradeDetails, error) {
+	offerID, err := parseOfferID(doc)
+	if err != nil {
+		return nil, err
+	}
+	details := &TradeDetails{OfferID: offerID}
+
+	if miniprofile, ok := doc.Find(".tradeoffer_partner [data-miniprofile]").Attr("data-miniprofile"); ok {
+		accountID, err := strconv.ParseUint(miniprofile, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		// individual account in the public universe
+		details.Partner = steamid.NewIdAdv(uint32(accountID), 1, 1, 1)
+	}
+
+	// The primary list belongs to whoever created the offer, its header
+	// tells whether that was us. The secondary list is the other side.
+	primary := doc.Find(".tradeoffer_items.primary")
+	createdByUs := strings.HasPrefix(strings.TrimSpace(primary.Find(".tradeoffer_items_header").Text()), "You")
+	primaryItems := parseDetailsItems(primary)
+	secondaryItems := parseDetailsItems(doc.Find(".tradeoffer_items.secondary"))
+	if createdByUs {
+		details.ItemsToGive, details.ItemsToReceive = primaryItems, secondaryItems
+	} else {
+		details.ItemsToGive, details.ItemsToReceive = secondaryItems, primaryItems
+	}
+
+	return details, nil
+}
+
+// Items reference their class as `classinfo/<appid>/<classid>[/<instanceid>]`.
+func parseDetailsItems(s *goquery.Selection) []*DetailsItem {
+	var items []*DetailsItem
+	s.Find("[data-economy-item]").Each(func(_ int, item *goquery.Selection) {
+		value, _ := item.Attr("data-economy-item")
+		parts := strings.Split(value, "/")
+		if len(parts) < 3 || parts[0] != "classinfo" {
+			return
+		}
+		appID, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return
+		}
+		classID, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return
+		}
+		var instanceID uint64
+		if len(parts) > 3 {
+			instanceID, _ = strconv.ParseUint(parts[3], 10, 64)
+		}
+		items = append(items, &DetailsItem{
+			AppID:      uint32(appID),
+			ClassID:    classID,
+			InstanceID: instanceID,
+		})
+	})
+	return items
+}
+
+func parseMarketListingDetails(conf *Confirmation, doc *goquery.Document) (*MarketListingDetails, error) {
+	// for market listings the creator is the listing itself
+	listingID, err := strconv.ParseUint(conf.CreatorID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := doc.Find(".mobileconf_listing_prices").Text()
+	m := listingReceiveRegex.FindStringSubmatch(prices)
+	if m == nil {
+		return nil, ErrCannotFindListingPrice
+	}
+	price, err := parsePrice(m[1])
+	if err != nil {
+		return nil, err
+	}
+
+	details := &MarketListingDetails{
+		ListingID: listingID,
+		Price:     price,
+	}
+	if m := listingBuyerPaysRegex.FindStringSubmatch(prices); m != nil {
+		details.BuyerPays, _ = parsePrice(m[1])
+	}
+	return details, nil
+}
+
+// parsePrice converts a formatted wallet amount like "$1.23" or "1,23€"
+// into hundredths, which Steam uses for all currencies. Currencies without
+// minor units like JPY and KRW are multiplied by 100 as well, so "¥ 980" is 98000.
+//
+// Only the last separator between digits can start the decimals, and only if
+// it's not followed by exactly three digits. "--" stands for zero decimals,
+// like in "20,--€".
+func parsePrice(s string) (uint64, error) {
+	s = strings.ReplaceAll(s, "--", "00")
+	digits := make([]byte, 0, len(s))
+	decimals := -1
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch >= '0' && ch <= '9':
+			digits = append(digits, ch)
+			if decimals >= 0 {
+				decimals++
+			}
+		case ch == '.' || ch == ',':
+			// separators in front of the amount or after it, like in
+			// "S/.1.23" or "57 pуб.", aren't part of the number
+			if len(digits) > 0 && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9' {
+				decimals = 0
+			}
+		}
+	}
+	if len(digits) == 0 {
+		return 0, ErrCannotFindListingPrice
+	}
+	// a separator followed by three digits is a thousands separator
+	if decimals == 3 || decimals < 0 {
+		decimals = 0
+	}
+	if decimals > 2 {
+		return 0, ErrCannotFindListingPrice
+	}
+	for ; decimals < 2; decimals++ {
+		digits = append(digits, '0')
+	}
+	return strconv.ParseUint(string(digits), 10, 64)
+}
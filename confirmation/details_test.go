@@ -0,0 +1,155 @@
+package confirmation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestParsePrice(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		price uint64
+	}{
+		// USD
+		{"$0.03", 3},
+		{"$1.23", 123},
+		{"$1,234.56", 123456},
+		{"$1,234", 123400},
+		// EUR
+		{"1,23€", 123},
+		{"1.234,56€", 123456},
+		{"20,--€", 2000},
+		// RUB
+		{"57 pуб.", 5700},
+		{"1 234,56 pуб.", 123456},
+		{"1\u00a0234,56 pуб.", 123456}, // with a no-break space
+		// JPY, which has no minor units
+		{"¥ 980", 98000},
+		{"¥ 1,234", 123400},
+		// KRW, which has no minor units
+		{"₩ 12,000", 1200000},
+		{"₩ 1,234,567", 123456700},
+		// separators in front of the amount
+		{"S/.1.23", 123},
+	} {
+		price, err := parsePrice(test.s)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", test.s, err)
+		} else if price != test.price {
+			t.Errorf("Expected %d for %q, got %d", test.price, test.s, price)
+		}
+	}
+}
+
+func TestParsePriceInvalid(t *testing.T) {
+	for _, s := range []string{"", "$", "free", "1.2345"} {
+		if price, err := parsePrice(s); err == nil {
+			t.Errorf("Expected an error for %q, got %d", s, price)
+		}
+	}
+}
+
+func TestListingPriceRegex(t *testing.T) {
+	for text, expected := range map[string]uint64{
+		"You receive: $1,234.56 Buyer pays: $1,419.24": 123456,
+		"You receive: 20,--€\nBuyer pays: 23,--€":      2000,
+		"You receive: 1 234,56 pуб. Buyer pays: 1 420": 123456,
+		"You receive: ¥ 1,234 Buyer pays: ¥ 1,419":     123400,
+	} {
+		m := listingReceiveRegex.FindStringSubmatch(text)
+		if m == nil {
+			t.Errorf("No price found in %q", text)
+			continue
+		}
+		if price, err := parsePrice(m[1]); err != nil || price != expected {
+			t.Errorf("Expected %d for %q, got %d, %v", expected, text, price, err)
+		}
+	}
+}
+
+// Like the details page of a trade confirmation, shortened
+const tradeDetailsPage = `<div class="mobileconf_trade_area">
+	<div class="tradeoffer" id="tradeofferid_4321">
+		<div class="tradeoffer_partner">
+			<div class="playerAvatar" data-miniprofile="46143802"></div>
+		</div>
+		<div class="tradeoffer_items_ctn">
+			<div class="tradeoffer_items primary">
+				<div class="tradeoffer_items_header">%s</div>
+				<div class="tradeoffer_item_list">
+					<div class="trade_item" data-economy-item="classinfo/440/101/11"></div>
+					<div class="trade_item" data-economy-item="classinfo/440/102"></div>
+				</div>
+			</div>
+			<div class="tradeoffer_items secondary">
+				<div class="tradeoffer_items_header">%s</div>
+				<div class="tradeoffer_item_list">
+					<div class="trade_item" data-economy-item="classinfo/730/201/21"></div>
+				</div>
+			</div>
+		</div>
+	</div>
+</div>`
+
+func TestParseTradeDetails(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		primary       string
+		secondary     string
+		give, receive []uint64
+	}{
+		{"created by us", "You offered:", "For your trade partner's:", []uint64{101, 102}, []uint64{201}},
+		{"created by the partner", "Partner offered:", "For your:", []uint64{201}, []uint64{101, 102}},
+	} {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(fmt.Sprintf(tradeDetailsPage, test.primary, test.secondary)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		details, err := parseTradeDetails(doc)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if details.OfferID != 4321 || details.Partner != 76561198006409530 {
+			t.Errorf("%s: unexpected offer %d with %v", test.name, details.OfferID, details.Partner)
+		}
+		if ids := classIDs(details.ItemsToGive); !reflect.DeepEqual(ids, test.give) {
+			t.Errorf("%s: expected to give %v, got %v", test.name, test.give, ids)
+		}
+		if ids := classIDs(details.ItemsToReceive); !reflect.DeepEqual(ids, test.receive) {
+			t.Errorf("%s: expected to receive %v, got %v", test.name, test.receive, ids)
+		}
+	}
+}
+
+func classIDs(items []*DetailsItem) []uint64 {
+	var ids []uint64
+	for _, item := range items {
+		ids = append(ids, item.ClassID)
+	}
+	return ids
+}
+
+func TestParseMarketListingDetailsUnit(t *testing.T) {
+	// prices are in hundredths of the displayed amount, also without decimals
+	for text, expected := range map[string]uint64{
+		"You receive: $1.23 Buyer pays: $1.41":       123,
+		"You receive: ¥ 980 Buyer pays: ¥ 1,127":     98000,
+		"You receive: ₩ 12,000 Buyer pays: ₩ 13,800": 1200000,
+	} {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div class="mobileconf_listing_prices">` + text + `</div>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		details, err := parseMarketListingDetails(&Confirmation{CreatorID: "1234"}, doc)
+		if err != nil {
+			t.Fatalf("%q: %v", text, err)
+		}
+		if details.Price != expected || details.ListingID != 1234 {
+			t.Errorf("Expected %d for %q, got %+v", expected, text, details)
+		}
+	}
+}
@@ -4,5 +4,6 @@ import "errors"
 
 var (
 	ErrCannotFindOffer        = errors.New("unable to find offer")
+	ErrCannotFindListingPrice = errors.New("unable to find listing price")
 	ErrCannotGetConfirmations = errors.New("unable to get confirmations")
 )
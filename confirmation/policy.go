@@ -0,0 +1,94 @@
+package confirmation
+
+import "fmt"
+
+// Decision is the outcome of a Policy for a single confirmation.
+type Decision uint
+
+const (
+	// The policy has no opinion, the next policy is asked.
+	DecisionAbstain Decision = iota
+	// Leave the confirmation pending and stop asking further policies.
+	DecisionIgnore
+	DecisionAccept
+	DecisionCancel
+)
+
+func (d Decision) String() string {
+	switch d {
+	case DecisionAbstain:
+		return "abstain"
+	case DecisionIgnore:
+		return "ignore"
+	case DecisionAccept:
+		return "accept"
+	case DecisionCancel:
+		return "cancel"
+	}
+	return fmt.Sprintf("Decision(%d)", uint(d))
+}
+
+// A Policy decides what the Watcher should do with a confirmation.
+// Policies are asked in order and the first one that doesn't abstain wins.
+// If a policy returns an error, the later ones aren't asked and the
+// confirmation is evaluated again on the next poll, up to
+// WatcherConfig.MaxAttempts times.
+// The returned reason is written to the audit log.
+type Policy interface {
+	Decide(client *Client, conf *Confirmation) (decision Decision, reason string, err error)
+}
+
+type PolicyFunc func(client *Client, conf *Confirmation) (Decision, string, error)
+
+func (f PolicyFunc) Decide(client *Client, conf *Confirmation) (Decision, string, error) {
+	return f(client, conf)
+}
+
+// Never lets an account recovery confirmation be answered automatically.
+// Put it in front of every other policy.
+func NeverAcceptAccountRecovery() Policy {
+	return PolicyFunc(func(client *Client, conf *Confirmation) (Decision, string, error) {
+		if conf.Type != ConfirmationTypeAccountRecovery {
+			return DecisionAbstain, "", nil
+		}
+		return DecisionIgnore, "account recovery is never answered automatically", nil
+	})
+}
+
+// Accepts trade confirmations for offers that isOwn reports as created by us.
+// Trades for other offers are left to the following policies.
+func AcceptOwnTrades(isOwn func(offerID uint64) bool) Policy {
+	return PolicyFunc(func(client *Client, conf *Confirmation) (Decision, string, error) {
+		if conf.Type != ConfirmationTypeTrade {
+			return DecisionAbstain, "", nil
+		}
+		offerID, err := client.GetOfferID(conf)
+		if err != nil {
+			return DecisionAbstain, "", err
+		}
+		if !isOwn(offerID) {
+			return DecisionAbstain, "", nil
+		}
+		return DecisionAccept, fmt.Sprintf("trade offer %d was created by us", offerID), nil
+	})
+}
+
+// Accepts market listing confirmations where we receive less than threshold,
+// given in hundredths of the displayed amount like MarketListingDetails.Price.
+// For currencies without decimals like JPY, ¥ 1000 is 100000.
+func AcceptMarketListingsBelow(threshold uint64) Policy {
+	return PolicyFunc(func(client *Client, conf *Confirmation) (Decision, string, error) {
+		if conf.Type != ConfirmationTypeMarketListing {
+			return DecisionAbstain, "", nil
+		}
+		details, err := client.Details(conf)
+		if err != nil {
+			return DecisionAbstain, "", err
+		}
+		price := details.MarketListing.Price
+		if price >= threshold {
+			return DecisionAbstain, "", nil
+		}
+		return DecisionAccept, fmt.Sprintf("listing %d price %d is below %d", details.MarketListing.ListingID, price, threshold), nil
+	})
+}
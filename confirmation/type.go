@@ -1,19 +1,87 @@
 package confirmation
 
+import "github.com/vuquang23/go-steam/steamid"
+
+type ConfirmationType uint64
+
+const (
+	ConfirmationTypeInvalid           ConfirmationType = 0
+	ConfirmationTypeTest              ConfirmationType = 1
+	ConfirmationTypeTrade             ConfirmationType = 2
+	ConfirmationTypeMarketListing     ConfirmationType = 3
+	ConfirmationTypeFeatureOptOut     ConfirmationType = 4
+	ConfirmationTypePhoneNumberChange ConfirmationType = 5
+	ConfirmationTypeAccountRecovery   ConfirmationType = 6
+	ConfirmationTypeAPIKey            ConfirmationType = 9
+	ConfirmationTypeJoinSteamFamily   ConfirmationType = 11
+)
+
+var confirmationTypeNames = map[ConfirmationType]string{
+	ConfirmationTypeInvalid:           "Invalid",
+	ConfirmationTypeTest:              "Test",
+	ConfirmationTypeTrade:             "Trade",
+	ConfirmationTypeMarketListing:     "MarketListing",
+	ConfirmationTypeFeatureOptOut:     "FeatureOptOut",
+	ConfirmationTypePhoneNumberChange: "PhoneNumberChange",
+	ConfirmationTypeAccountRecovery:   "AccountRecovery",
+	ConfirmationTypeAPIKey:            "APIKey",
+	ConfirmationTypeJoinSteamFamily:   "JoinSteamFamily",
+}
+
+func (t ConfirmationType) String() string {
+	if name, ok := confirmationTypeNames[t]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
 type Confirmation struct {
-	Type         uint64      `json:"type"`
-	TypeName     string      `json:"type_name"`
-	ID           string      `json:"id"`
-	CreatorID    string      `json:"creator_id"`
-	Nonce        string      `json:"nonce"`
-	CreationTime uint64      `json:"creation_time"`
-	Cancel       string      `json:"cancel"`
-	Accept       string      `json:"accept"`
-	Icon         string      `json:"icon"`
-	Multi        bool        `json:"multi"`
-	Headline     string      `json:"headline"`
-	Summary      []string    `json:"summary"`
-	Warn         interface{} `json:"warn"`
+	Type         ConfirmationType `json:"type"`
+	TypeName     string           `json:"type_name"`
+	ID           string           `json:"id"`
+	CreatorID    string           `json:"creator_id"`
+	Nonce        string           `json:"nonce"`
+	CreationTime uint64           `json:"creation_time"`
+	Cancel       string           `json:"cancel"`
+	Accept       string           `json:"accept"`
+	Icon         string           `json:"icon"`
+	Multi        bool             `json:"multi"`
+	Headline     string           `json:"headline"`
+	Summary      []string         `json:"summary"`
+	Warn         interface{}      `json:"warn"`
+}
+
+// Details holds the structured content of a confirmation's details page.
+// At most one of Trade and MarketListing is set, depending on Type.
+type Details struct {
+	Type          ConfirmationType
+	Trade         *TradeDetails
+	MarketListing *MarketListingDetails
+}
+
+type TradeDetails struct {
+	OfferID        uint64
+	Partner        steamid.SteamId
+	ItemsToGive    []*DetailsItem
+	ItemsToReceive []*DetailsItem
+}
+
+// An item as referenced on the details page. Descriptions have to be looked up
+// separately by ClassID and InstanceID.
+type DetailsItem struct {
+	AppID      uint32
+	ClassID    uint64
+	InstanceID uint64
+}
+
+// Prices are in hundredths of the displayed amount, like Steam stores them
+// for every currency. That's cents for USD, but ¥ 980 is 98000.
+type MarketListingDetails struct {
+	ListingID uint64
+	// Amount we receive after fees.
+	Price uint64
+	// Amount the buyer pays including fees. Zero if not shown.
+	BuyerPays uint64
 }
 
 type jsonObj = map[string]interface{}
@@ -0,0 +1,318 @@
+package confirmation
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWatchInterval = 30 * time.Second
+	defaultMaxBackoff    = 5 * time.Minute
+	defaultMaxAttempts   = 5
+)
+
+type WatcherConfig struct {
+	// How often confirmations are fetched. Defaults to 30 seconds.
+	Interval time.Duration
+	// Upper bound for the polling interval after consecutive errors.
+	// Defaults to 5 minutes.
+	MaxBackoff time.Duration
+	// Asked in order for every new confirmation. Without policies the watcher
+	// only emits events.
+	Policies []Policy
+	// Receives every decision made by the policies. May be nil.
+	AuditLog AuditLog
+	// How often the policies are applied to a confirmation whose policies or
+	// answer failed, before the watcher gives up on it. Defaults to 5.
+	MaxAttempts int
+}
+
+// Watcher polls the confirmation list and applies policies to new confirmations.
+// Always poll events from the channel returned by Events() or polling will stop.
+type Watcher struct {
+	client *Client
+	config WatcherConfig
+
+	events chan interface{}
+
+	mutex   sync.Mutex
+	stop    chan struct{}
+	done    chan struct{}
+	pending map[string]*Confirmation
+	// ids of listed confirmations the policies are done with
+	settled map[string]bool
+	// failed attempts of listed confirmations by id
+	failures map[string]int
+}
+
+func NewWatcher(client *Client, config WatcherConfig) *Watcher {
+	if config.Interval <= 0 {
+		config.Interval = defaultWatchInterval
+	}
+	if config.MaxBackoff < config.Interval {
+		config.MaxBackoff = defaultMaxBackoff
+		if config.MaxBackoff < config.Interval {
+			config.MaxBackoff = config.Interval
+		}
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	return &Watcher{
+		client:   client,
+		config:   config,
+		events:   make(chan interface{}, 3),
+		pending:  make(map[string]*Confirmation),
+		settled:  make(map[string]bool),
+		failures: make(map[string]int),
+	}
+}
+
+// Get the event channel. All events are pointers, except for errors.
+// It is never closed.
+func (w *Watcher) Events() <-chan interface{} {
+	return w.events
+}
+
+// Starts polling in a new goroutine. Calling Start on a running watcher does nothing.
+func (w *Watcher) Start() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.stop != nil {
+		return
+	}
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	go w.loop(w.stop, w.done)
+}
+
+// Stops polling and waits for the current poll to finish.
+func (w *Watcher) Stop() {
+	w.mutex.Lock()
+	stop, done := w.stop, w.done
+	w.stop, w.done = nil, nil
+	w.mutex.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (w *Watcher) loop(stop, done chan struct{}) {
+	defer close(done)
+	delay := w.config.Interval
+	for {
+		if err := w.Poll(); err != nil {
+			w.emit(stop, err)
+			delay *= 2
+			if delay > w.config.MaxBackoff {
+				delay = w.config.MaxBackoff
+			}
+		} else {
+			delay = w.config.Interval
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Fetches the confirmation list once, emits events for new and disappeared
+// confirmations and applies the policies to the new ones. Confirmations
+// that a policy failed to evaluate or that Steam failed to answer are
+// tried again on the next poll, until they failed MaxAttempts times.
+// Returns the first of these errors, so that Start backs off.
+//
+// It is called periodically by Start, but may also be called directly.
+// Like the watcher itself, a direct call blocks while the event channel is
+// full, so keep reading events.
+func (w *Watcher) Poll() error {
+	confs, err := w.client.GetConfirmations()
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]*Confirmation, len(confs))
+	for _, conf := range confs {
+		current[conf.ID] = conf
+	}
+
+	w.mutex.Lock()
+	stop := w.stop
+	var added, removed, unsettled []*Confirmation
+	for id, conf := range current {
+		if _, ok := w.pending[id]; !ok {
+			added = append(added, conf)
+		}
+		if !w.settled[id] {
+			unsettled = append(unsettled, conf)
+		}
+	}
+	for id, conf := range w.pending {
+		if _, ok := current[id]; !ok {
+			removed = append(removed, conf)
+			delete(w.settled, id)
+			delete(w.failures, id)
+		}
+	}
+	w.pending = current
+	w.mutex.Unlock()
+
+	for _, conf := range removed {
+		w.emit(stop, &ConfirmationGoneEvent{conf})
+	}
+	for _, conf := range added {
+		w.emit(stop, &NewConfirmationEvent{conf})
+	}
+	var firstErr error
+	for _, conf := range unsettled {
+		err := w.apply(stop, conf)
+		w.mutex.Lock()
+		_, listed := w.pending[conf.ID]
+		abandoned := false
+		if err != nil && listed {
+			w.failures[conf.ID]++
+			abandoned = w.failures[conf.ID] >= w.config.MaxAttempts
+		}
+		if listed && (err == nil || abandoned) {
+			w.settled[conf.ID] = true
+			delete(w.failures, conf.ID)
+		}
+		w.mutex.Unlock()
+
+		if abandoned {
+			w.emit(stop, &ConfirmationAbandonedEvent{conf, err})
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Asks the policies in order until one decides and sends the decision.
+// An error of a policy stops the evaluation, so that a later, more lenient
+// policy doesn't decide in its place. Returns nil if the confirmation was
+// settled, which is when a decision was sent or every policy abstained.
+func (w *Watcher) apply(stop chan struct{}, conf *Confirmation) error {
+	for _, policy := range w.config.Policies {
+		decision, reason, err := policy.Decide(w.client, conf)
+		if err != nil {
+			w.audit(conf, DecisionAbstain, reason, err)
+			return err
+		}
+		if decision == DecisionAbstain {
+			continue
+		}
+
+		switch decision {
+		case DecisionAccept:
+			err = w.client.AcceptConfirmation(conf)
+		case DecisionCancel:
+			err = w.client.CancelConfirmation(conf)
+		}
+		w.audit(conf, decision, reason, err)
+		if err != nil {
+			return err
+		}
+		w.emit(stop, &ConfirmationDecisionEvent{conf, decision, reason})
+		return nil
+	}
+	return nil
+}
+
+func (w *Watcher) audit(conf *Confirmation, decision Decision, reason string, err error) {
+	if w.config.AuditLog == nil {
+		return
+	}
+	entry := &AuditEntry{
+		Time:           time.Now(),
+		ConfirmationID: conf.ID,
+		CreatorID:      conf.CreatorID,
+		Type:           conf.Type,
+		Headline:       conf.Headline,
+		Decision:       decision,
+		Reason:         reason,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	w.config.AuditLog.Log(entry)
+}
+
+// emit doesn't block forever if the watcher is stopped while nobody reads events.
+// Without a running watcher it blocks until the event is read.
+func (w *Watcher) emit(stop chan struct{}, event interface{}) {
+	if stop == nil {
+		w.events <- event
+		return
+	}
+	select {
+	case w.events <- event:
+	case <-stop:
+	}
+}
+
+// Fired when a confirmation shows up for the first time.
+type NewConfirmationEvent struct {
+	Confirmation *Confirmation
+}
+
+// Fired when a confirmation is no longer listed, either because it was answered or because it expired.
+type ConfirmationGoneEvent struct {
+	Confirmation *Confirmation
+}
+
+// Fired after a policy decision was successfully sent to Steam.
+type ConfirmationDecisionEvent struct {
+	Confirmation *Confirmation
+	Decision     Decision
+	Reason       string
+}
+
+// Fired when the watcher gives up on a confirmation after it failed
+// MaxAttempts times. It stays pending and is left to the user.
+type ConfirmationAbandonedEvent struct {
+	Confirmation *Confirmation
+	// The error of the last attempt
+	Err error
+}
+
+type AuditEntry struct {
+	Time           time.Time
+	ConfirmationID string
+	CreatorID      string
+	Type           ConfirmationType
+	Headline       string
+	Decision       Decision
+	Reason         string
+	Error          string `json:",omitempty"`
+}
+
+// AuditLog records every decision made by a Watcher, including failed ones.
+type AuditLog interface {
+	Log(entry *AuditEntry)
+}
+
+type AuditLogFunc func(entry *AuditEntry)
+
+func (f AuditLogFunc) Log(entry *AuditEntry) {
+	f(entry)
+}
+
+// Returns an AuditLog that writes one JSON object per line to w.
+func NewJSONAuditLog(w io.Writer) AuditLog {
+	var mutex sync.Mutex
+	enc := json.NewEncoder(w)
+	return AuditLogFunc(func(entry *AuditEntry) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		enc.Encode(entry)
+	})
+}
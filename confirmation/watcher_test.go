@@ -0,0 +1,64 @@
+package confirmation
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Returns a client whose requests are all answered with body.
+func newTestClient(body string) *Client {
+	c := NewClient("session", "android:device", "AAAAAAAAAAAAAAAAAAAAAAAAAAA=", "76561197960287930")
+	c.client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     make(http.Header),
+		}, nil
+	})
+	return c
+}
+
+func TestWatcherAbandonsFailingConfirmation(t *testing.T) {
+	client := newTestClient(`{"success":true,"conf":[{"id":"1","type":2,"nonce":"n"}]}`)
+	policyErr := errors.New("policy failed")
+	calls := 0
+	w := NewWatcher(client, WatcherConfig{
+		MaxAttempts: 2,
+		Policies: []Policy{PolicyFunc(func(client *Client, conf *Confirmation) (Decision, string, error) {
+			calls++
+			return DecisionAbstain, "", policyErr
+		})},
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := w.Poll(); err != policyErr {
+			t.Errorf("Expected poll %d to return %v, got %v", i, policyErr, err)
+		}
+	}
+	if err := w.Poll(); err != nil {
+		t.Errorf("Expected nil after giving up, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected the policy to be asked 2 times, got %d", calls)
+	}
+
+	if _, ok := (<-w.Events()).(*NewConfirmationEvent); !ok {
+		t.Errorf("Expected a NewConfirmationEvent first")
+	}
+	event, ok := (<-w.Events()).(*ConfirmationAbandonedEvent)
+	if !ok {
+		t.Fatalf("Expected a ConfirmationAbandonedEvent")
+	}
+	if event.Confirmation.ID != "1" || event.Err != policyErr {
+		t.Errorf("Expected confirmation 1 abandoned with %v, got %s with %v", policyErr, event.Confirmation.ID, event.Err)
+	}
+}
@@ -0,0 +1,190 @@
+package inventory
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+const defaultCacheTTL = 5 * time.Minute
+
+type CacheKey struct {
+	SteamId   steamid.SteamId
+	AppId     uint32
+	ContextId uint64
+}
+
+type CachedInventory struct {
+	Inventory *Inventory
+	Fetched   time.Time
+}
+
+// CacheBackend stores the inventories of a Cache, for example to share them
+// between processes or keep them across restarts.
+type CacheBackend interface {
+	// Returns nil and no error for unknown keys.
+	Get(key CacheKey) (*CachedInventory, error)
+	Put(key CacheKey, entry *CachedInventory) error
+	Delete(key CacheKey) error
+}
+
+type CacheConfig struct {
+	// How long an inventory is served from the cache. Defaults to five minutes.
+	TTL time.Duration
+	// Defaults to NewMemoryCacheBackend().
+	Backend CacheBackend
+	// Options for GetInventory, may be nil.
+	Options *InventoryOptions
+	// Replaces GetInventory for loading inventories.
+	Load func(key CacheKey) (*Inventory, error)
+}
+
+// Cache keeps inventories for a while so that they aren't downloaded again
+// for every lookup. Use Refresh after a trade to get the new state and what changed.
+type Cache struct {
+	client *http.Client
+	config CacheConfig
+
+	// one lock per key, so that concurrent lookups load an inventory once.
+	// Locks are removed when nobody holds or waits for them.
+	mutex sync.Mutex
+	locks map[CacheKey]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	// goroutines holding or waiting for the lock, guarded by Cache.mutex
+	refs int
+}
+
+func NewCache(client *http.Client, config CacheConfig) *Cache {
+	if config.TTL <= 0 {
+		config.TTL = defaultCacheTTL
+	}
+	if config.Backend == nil {
+		config.Backend = NewMemoryCacheBackend()
+	}
+	return &Cache{
+		client: client,
+		config: config,
+		locks:  make(map[CacheKey]*keyLock),
+	}
+}
+
+func (c *Cache) lock(key CacheKey) func() {
+	c.mutex.Lock()
+	l, ok := c.locks[key]
+	if !ok {
+		l = new(keyLock)
+		c.locks[key] = l
+	}
+	l.refs++
+	c.mutex.Unlock()
+	l.Lock()
+	return func() {
+		l.Unlock()
+		c.mutex.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(c.locks, key)
+		}
+		c.mutex.Unlock()
+	}
+}
+
+func (c *Cache) load(key CacheKey) (*Inventory, error) {
+	if c.config.Load != nil {
+		return c.config.Load(key)
+	}
+	return GetInventory(c.client, key.SteamId, key.AppId, key.ContextId, c.config.Options)
+}
+
+// Returns the cached inventory, or loads it if it isn't cached or expired.
+func (c *Cache) Get(steamId steamid.SteamId, appId uint32, contextId uint64) (*Inventory, error) {
+	key := CacheKey{steamId, appId, contextId}
+	defer c.lock(key)()
+
+	entry, err := c.config.Backend.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil && time.Since(entry.Fetched) < c.config.TTL {
+		return entry.Inventory, nil
+	}
+	inv, err := c.load(key)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.config.Backend.Put(key, &CachedInventory{inv, time.Now()}); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// Loads the inventory regardless of its age and returns it together with the
+// changes to the previously cached state. Everything is reported as added if
+// nothing was cached.
+func (c *Cache) Refresh(steamId steamid.SteamId, appId uint32, contextId uint64) (*Inventory, *InventoryDiff, error) {
+	inv, diff, _, err := c.refresh(CacheKey{steamId, appId, contextId})
+	return inv, diff, err
+}
+
+// Like Refresh, but also returns whether there was a cached state
+func (c *Cache) refresh(key CacheKey) (*Inventory, *InventoryDiff, bool, error) {
+	defer c.lock(key)()
+
+	entry, err := c.config.Backend.Get(key)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	inv, err := c.load(key)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if err = c.config.Backend.Put(key, &CachedInventory{inv, time.Now()}); err != nil {
+		return nil, nil, false, err
+	}
+	var old *Inventory
+	if entry != nil {
+		old = entry.Inventory
+	}
+	return inv, Diff(old, inv), entry != nil, nil
+}
+
+// Removes an inventory from the cache, the next Get loads it again.
+func (c *Cache) Invalidate(steamId steamid.SteamId, appId uint32, contextId uint64) error {
+	key := CacheKey{steamId, appId, contextId}
+	defer c.lock(key)()
+	return c.config.Backend.Delete(key)
+}
+
+type memoryCacheBackend struct {
+	mutex   sync.RWMutex
+	entries map[CacheKey]*CachedInventory
+}
+
+func NewMemoryCacheBackend() CacheBackend {
+	return &memoryCacheBackend{entries: make(map[CacheKey]*CachedInventory)}
+}
+
+func (b *memoryCacheBackend) Get(key CacheKey) (*CachedInventory, error) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.entries[key], nil
+}
+
+func (b *memoryCacheBackend) Put(key CacheKey, entry *CachedInventory) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.entries[key] = entry
+	return nil
+}
+
+func (b *memoryCacheBackend) Delete(key CacheKey) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.entries, key)
+	return nil
+}
@@ -0,0 +1,41 @@
+package inventory
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCacheLoadsOnceAndReleasesLocks(t *testing.T) {
+	var mutex sync.Mutex
+	loads := 0
+	cache := NewCache(nil, CacheConfig{
+		Load: func(key CacheKey) (*Inventory, error) {
+			mutex.Lock()
+			loads++
+			mutex.Unlock()
+			return new(Inventory), nil
+		},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.Get(1, 730, 2); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if err := cache.Invalidate(1, 440, 2); err != nil {
+		t.Error(err)
+	}
+
+	if loads != 1 {
+		t.Errorf("Expected 1 load, got %d", loads)
+	}
+	if len(cache.locks) != 0 {
+		t.Errorf("Expected no locks to be left, got %d", len(cache.locks))
+	}
+}
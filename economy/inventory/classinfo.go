@@ -0,0 +1,284 @@
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+const assetClassInfoUrl = "https://api.steampowered.com/ISteamEconomy/GetAssetClassInfo/v1/"
+
+// Steam answers larger requests with an error.
+const assetClassInfoBatchSize = 100
+
+type ClassInstance struct {
+	ClassId    uint64
+	InstanceId uint64
+}
+
+type classInfoKey struct {
+	appId uint32
+	ClassInstance
+}
+
+// DescriptionResolver looks up descriptions by class and instance through
+// ISteamEconomy/GetAssetClassInfo, for items that came without one, like in
+// the trade history. Class info doesn't change, so every description is cached
+// for the lifetime of the resolver. It is safe for concurrent use.
+type DescriptionResolver struct {
+	client   *http.Client
+	apiKey   string
+	language string
+
+	mutex sync.RWMutex
+	cache map[classInfoKey]*Description
+}
+
+// Language is like "english", empty defaults to English.
+func NewDescriptionResolver(client *http.Client, apiKey string, language string) *DescriptionResolver {
+	if language == "" {
+		language = "english"
+	}
+	return &DescriptionResolver{
+		client:   client,
+		apiKey:   apiKey,
+		language: language,
+		cache:    make(map[classInfoKey]*Description),
+	}
+}
+
+// Returns the descriptions of the given classes of an app, keyed like
+// Inventory.Descriptions. Cached descriptions are not requested again.
+// Classes Steam doesn't know are missing from the result.
+func (r *DescriptionResolver) Resolve(appId uint32, classes []ClassInstance) (Descriptions, error) {
+	result := make(Descriptions, len(classes))
+	var missing []ClassInstance
+	seen := make(map[ClassInstance]bool, len(classes))
+	r.mutex.RLock()
+	for _, class := range classes {
+		if seen[class] {
+			continue
+		}
+		seen[class] = true
+		if desc, ok := r.cache[classInfoKey{appId, class}]; ok {
+			result[descriptionKey(class)] = desc
+		} else {
+			missing = append(missing, class)
+		}
+	}
+	r.mutex.RUnlock()
+
+	for start := 0; start < len(missing); start += assetClassInfoBatchSize {
+		end := start + assetClassInfoBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+		descs, err := r.request(appId, missing[start:end])
+		if err != nil {
+			return nil, err
+		}
+		r.mutex.Lock()
+		for class, desc := range descs {
+			r.cache[classInfoKey{appId, class}] = desc
+			result[descriptionKey(class)] = desc
+		}
+		r.mutex.Unlock()
+	}
+	return result, nil
+}
+
+func (r *DescriptionResolver) Get(appId uint32, classId uint64, instanceId uint64) (*Description, error) {
+	class := ClassInstance{classId, instanceId}
+	descs, err := r.Resolve(appId, []ClassInstance{class})
+	if err != nil {
+		return nil, err
+	}
+	if desc, ok := descs[descriptionKey(class)]; ok {
+		return desc, nil
+	}
+	return nil, fmt.Errorf("description not found")
+}
+
+// Adds the descriptions that are missing for items of the inventory.
+func (r *DescriptionResolver) Fill(inv *Inventory, appId uint32) error {
+	var missing []ClassInstance
+	for _, item := range inv.Items {
+		if _, err := inv.Descriptions.Get(item.ClassId, item.InstanceId); err != nil {
+			missing = append(missing, ClassInstance{item.ClassId, item.InstanceId})
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	descs, err := r.Resolve(appId, missing)
+	if err != nil {
+		return err
+	}
+	if inv.Descriptions == nil {
+		inv.Descriptions = make(Descriptions, len(descs))
+	}
+	for key, desc := range descs {
+		inv.Descriptions[key] = desc
+	}
+	return nil
+}
+
+func descriptionKey(class ClassInstance) string {
+	return fmt.Sprintf("%d_%d", class.ClassId, class.InstanceId)
+}
+
+func (r *DescriptionResolver) request(appId uint32, classes []ClassInstance) (map[ClassInstance]*Description, error) {
+	params := url.Values{
+		"key":         {r.apiKey},
+		"appid":       {strconv.FormatUint(uint64(appId), 10)},
+		"language":    {r.language},
+		"class_count": {strconv.Itoa(len(classes))},
+	}
+	for i, class := range classes {
+		params.Set("classid"+strconv.Itoa(i), strconv.FormatUint(class.ClassId, 10))
+		params.Set("instanceid"+strconv.Itoa(i), strconv.FormatUint(class.InstanceId, 10))
+	}
+	resp, err := r.client.Get(assetClassInfoUrl + "?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("asset class info request failed: status code %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Result map[string]json.RawMessage `json:"result"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	var success bool
+	if err = json.Unmarshal(body.Result["success"], &success); err != nil || !success {
+		var message string
+		json.Unmarshal(body.Result["error"], &message)
+		return nil, fmt.Errorf("asset class info request failed: %s", message)
+	}
+
+	result := make(map[ClassInstance]*Description, len(classes))
+	for _, class := range classes {
+		// the key is only the class id if no instance was asked for
+		raw, ok := body.Result[descriptionKey(class)]
+		if !ok {
+			raw, ok = body.Result[strconv.FormatUint(class.ClassId, 10)]
+		}
+		if !ok {
+			continue
+		}
+		var info classInfo
+		if err = json.Unmarshal(raw, &info); err != nil {
+			return nil, err
+		}
+		if result[class], err = info.toDescription(appId, class); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Class info as sent by GetAssetClassInfo. All numbers are strings and
+// lists are objects keyed by index.
+type classInfo struct {
+	IconUrl                   string          `json:"icon_url"`
+	IconUrlLarge              string          `json:"icon_url_large"`
+	IconDragUrl               string          `json:"icon_drag_url"`
+	Name                      string          `json:"name"`
+	MarketName                string          `json:"market_name"`
+	MarketHashName            string          `json:"market_hash_name"`
+	NameColor                 string          `json:"name_color"`
+	BackgroundColor           string          `json:"background_color"`
+	Type                      string          `json:"type"`
+	Tradable                  string          `json:"tradable"`
+	Marketable                string          `json:"marketable"`
+	Commodity                 string          `json:"commodity"`
+	MarketTradableRestriction string          `json:"market_tradable_restriction"`
+	Descriptions              json.RawMessage `json:"descriptions"`
+	Actions                   json.RawMessage `json:"actions"`
+	Tags                      json.RawMessage `json:"tags"`
+	AppData                   json.RawMessage `json:"app_data"`
+}
+
+func (c *classInfo) toDescription(appId uint32, class ClassInstance) (*Description, error) {
+	restriction, _ := strconv.ParseUint(c.MarketTradableRestriction, 10, 32)
+	desc := &Description{
+		AppId:                     appId,
+		ClassId:                   class.ClassId,
+		InstanceId:                class.InstanceId,
+		IconUrl:                   c.IconUrl,
+		IconUrlLarge:              c.IconUrlLarge,
+		IconDragUrl:               c.IconDragUrl,
+		Name:                      c.Name,
+		MarketName:                c.MarketName,
+		MarketHashName:            c.MarketHashName,
+		NameColor:                 c.NameColor,
+		BackgroundColor:           c.BackgroundColor,
+		Type:                      c.Type,
+		Tradable:                  c.Tradable == "1",
+		Marketable:                c.Marketable == "1",
+		Commodity:                 c.Commodity == "1",
+		MarketTradableRestriction: uint32(restriction),
+	}
+	// some apps nest objects in app data, only plain values are kept
+	var appData map[string]json.RawMessage
+	json.Unmarshal(c.AppData, &appData)
+	for key, raw := range appData {
+		var value string
+		if json.Unmarshal(raw, &value) == nil {
+			if desc.AppData == nil {
+				desc.AppData = make(map[string]string)
+			}
+			desc.AppData[key] = value
+		}
+	}
+	err := decodeIndexed(c.Descriptions, func(raw json.RawMessage) error {
+		line := new(DescriptionLine)
+		desc.Descriptions = append(desc.Descriptions, line)
+		return json.Unmarshal(raw, line)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = decodeIndexed(c.Actions, func(raw json.RawMessage) error {
+		action := new(Action)
+		desc.Actions = append(desc.Actions, action)
+		return json.Unmarshal(raw, action)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = decodeIndexed(c.Tags, func(raw json.RawMessage) error {
+		tag := new(Tag)
+		desc.Tags = append(desc.Tags, tag)
+		return json.Unmarshal(raw, tag)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// Decodes an object like {"0": ..., "1": ...} in order. Empty lists are sent as
+// empty strings, which are skipped.
+func decodeIndexed(data json.RawMessage, add func(raw json.RawMessage) error) error {
+	var m map[string]json.RawMessage
+	if json.Unmarshal(data, &m) != nil {
+		return nil
+	}
+	for i := 0; ; i++ {
+		raw, ok := m[strconv.Itoa(i)]
+		if !ok {
+			return nil
+		}
+		if err := add(raw); err != nil {
+			return err
+		}
+	}
+}
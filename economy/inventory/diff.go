@@ -0,0 +1,57 @@
+package inventory
+
+import "sort"
+
+type AmountChange struct {
+	// The item as it is now.
+	Item      *Item
+	OldAmount uint64
+}
+
+// InventoryDiff lists the differences between two states of an inventory,
+// matched by asset id.
+type InventoryDiff struct {
+	Added   []*Item
+	Removed []*Item
+	// Stackable items whose amount changed.
+	AmountChanged []*AmountChange
+}
+
+func (d *InventoryDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.AmountChanged) == 0
+}
+
+// Compares two states of the same inventory. Either may be nil, which is
+// treated as an empty inventory.
+//
+// Only Items are compared. Currencies are skipped, as Currency has no amount
+// that could change.
+func Diff(old, new *Inventory) *InventoryDiff {
+	var oldItems, newItems Items
+	if old != nil {
+		oldItems = old.Items
+	}
+	if new != nil {
+		newItems = new.Items
+	}
+
+	diff := &InventoryDiff{}
+	for id, item := range newItems {
+		oldItem, ok := oldItems[id]
+		if !ok {
+			diff.Added = append(diff.Added, item)
+		} else if oldItem.Amount != item.Amount {
+			diff.AmountChanged = append(diff.AmountChanged, &AmountChange{item, oldItem.Amount})
+		}
+	}
+	for id, item := range oldItems {
+		if _, ok := newItems[id]; !ok {
+			diff.Removed = append(diff.Removed, item)
+		}
+	}
+	// maps have no order, sort so that equal diffs look the same
+	sort.Slice(diff.Added, func(i, j int) bool { return diff.Added[i].Id < diff.Added[j].Id })
+	sort.Slice(diff.Removed, func(i, j int) bool { return diff.Removed[i].Id < diff.Removed[j].Id })
+	sort.Slice(diff.AmountChanged, func(i, j int) bool { return diff.AmountChanged[i].Item.Id < diff.AmountChanged[j].Item.Id })
+	return diff
+}
@@ -0,0 +1,73 @@
+package inventory
+
+import (
+	"strconv"
+	"testing"
+)
+
+func testInventory(amounts map[string]uint64) *Inventory {
+	items := make(Items)
+	for id, amount := range amounts {
+		assetId, _ := strconv.ParseUint(id, 10, 64)
+		items[id] = &Item{Id: assetId, Amount: amount}
+	}
+	return &Inventory{Items: items}
+}
+
+func TestDiff(t *testing.T) {
+	old := testInventory(map[string]uint64{"1": 1, "2": 1, "3": 5})
+	new := testInventory(map[string]uint64{"1": 1, "3": 7, "4": 1})
+	diff := Diff(old, new)
+	if len(diff.Added) != 1 || diff.Added[0].Id != 4 {
+		t.Errorf("Expected 4 to be added, got %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Id != 2 {
+		t.Errorf("Expected 2 to be removed, got %+v", diff.Removed)
+	}
+	if len(diff.AmountChanged) != 1 || diff.AmountChanged[0].Item.Id != 3 || diff.AmountChanged[0].OldAmount != 5 {
+		t.Errorf("Expected the amount of 3 to change from 5, got %+v", diff.AmountChanged)
+	}
+	if !Diff(old, old).Empty() {
+		t.Error("Expected no difference to itself")
+	}
+	if diff = Diff(nil, new); len(diff.Added) != 3 {
+		t.Errorf("Expected everything to be added to nil, got %+v", diff.Added)
+	}
+}
+
+func TestWatcherBaseline(t *testing.T) {
+	inventories := []*Inventory{
+		testInventory(map[string]uint64{"1": 1}),
+		testInventory(map[string]uint64{"1": 1}),
+		testInventory(map[string]uint64{"1": 1, "2": 1}),
+	}
+	cache := NewCache(nil, CacheConfig{
+		Load: func(key CacheKey) (*Inventory, error) {
+			inv := inventories[0]
+			inventories = inventories[1:]
+			return inv, nil
+		},
+	})
+	w := NewWatcher(cache, 0)
+	w.Watch(76561198006409530, 440, 2)
+	stop := make(chan struct{})
+
+	w.refresh(stop)
+	w.refresh(stop)
+	select {
+	case event := <-w.Events():
+		t.Fatalf("Expected no event for the baseline and an unchanged inventory, got %+v", event)
+	default:
+	}
+
+	w.refresh(stop)
+	select {
+	case event := <-w.Events():
+		e, ok := event.(*InventoryChangedEvent)
+		if !ok || len(e.Diff.Added) != 1 || e.Diff.Added[0].Id != 2 {
+			t.Fatalf("Expected 2 to be added, got %+v", event)
+		}
+	default:
+		t.Fatal("Expected an InventoryChangedEvent")
+	}
+}
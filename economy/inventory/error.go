@@ -0,0 +1,12 @@
+package inventory
+
+import "errors"
+
+var (
+	ErrNotLoggedIn     = errors.New("not logged in")
+	ErrProfileNotFound = errors.New("profile not found")
+	// The inventory or the whole profile isn't visible to us.
+	ErrPrivateInventory = errors.New("inventory is private")
+	// Steam kept answering with 429 Too Many Requests.
+	ErrRateLimited = errors.New("inventory requests are rate limited")
+)
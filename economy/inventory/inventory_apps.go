@@ -1,12 +1,16 @@
 package inventory
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/vuquang23/go-steam/steamid"
 )
@@ -56,8 +60,22 @@ type Context struct {
 	Name       string
 }
 
+var (
+	appContextDataRegex  = regexp.MustCompile(`var g_rgAppContextData = (.*?);`)
+	profileNotFoundRegex = regexp.MustCompile(`The specified profile could not be found`)
+	privateProfileRegex  = regexp.MustCompile(`class="profile_private_info"|This profile is private`)
+	signInRegex          = regexp.MustCompile(`<title>Sign In</title>`)
+)
+
+// Gets the apps and their contexts that have an inventory on the profile
+// page of steamId. The client's cookies are used, so our own page includes
+// apps that are only visible to us.
 func GetInventoryApps(client *http.Client, steamId steamid.SteamId) (InventoryApps, error) {
-	resp, err := http.Get("http://steamcommunity.com/profiles/" + steamId.ToString() + "/inventory/")
+	req, err := http.NewRequest("GET", "https://steamcommunity.com/profiles/"+steamId.ToString()+"/inventory/", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -66,15 +84,118 @@ func GetInventoryApps(client *http.Client, steamId steamid.SteamId) (InventoryAp
 	if err != nil {
 		return nil, err
 	}
-	reg := regexp.MustCompile("var g_rgAppContextData = (.*?);")
-	inventoryAppsMatches := reg.FindSubmatch(respBody)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("inventory page request failed: status code %d", resp.StatusCode)
+	}
+
+	inventoryAppsMatches := appContextDataRegex.FindSubmatch(respBody)
 	if inventoryAppsMatches == nil {
+		switch {
+		case signInRegex.Match(respBody) || strings.Contains(resp.Request.URL.Path, "/login"):
+			return nil, ErrNotLoggedIn
+		case privateProfileRegex.Match(respBody):
+			return nil, ErrPrivateInventory
+		case profileNotFoundRegex.Match(respBody):
+			return nil, ErrProfileNotFound
+		}
 		return nil, fmt.Errorf("profile inventory not found in steam response")
 	}
 	var inventoryApps InventoryApps
+	// an empty inventory is an empty array
+	if bytes.Equal(inventoryAppsMatches[1], []byte("[]")) {
+		return InventoryApps{}, nil
+	}
 	if err = json.Unmarshal(inventoryAppsMatches[1], &inventoryApps); err != nil {
 		return nil, err
 	}
 
 	return inventoryApps, nil
 }
+
+type AppContext struct {
+	AppId      uint32
+	ContextId  uint64
+	AssetCount uint32
+}
+
+// Returns every app and context of steamId that has at least one item, ordered by app and context.
+func GetAppContexts(client *http.Client, steamId steamid.SteamId) ([]AppContext, error) {
+	apps, err := GetInventoryApps(client, steamId)
+	if err != nil {
+		return nil, err
+	}
+	var result []AppContext
+	for _, app := range apps {
+		for _, context := range app.Contexts {
+			if context.AssetCount > 0 {
+				result = append(result, AppContext{app.AppId, context.ContextId, context.AssetCount})
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].AppId != result[j].AppId {
+			return result[i].AppId < result[j].AppId
+		}
+		return result[i].ContextId < result[j].ContextId
+	})
+	return result, nil
+}
+
+const defaultInventoryConcurrency = 2
+
+// Loads every non-empty inventory of steamId with GetInventory, concurrency of them at a time.
+// Defaults to two at a time, as Steam rate limits inventory requests quickly.
+// Returns the first error. Inventories that weren't started yet are skipped
+// then, but downloads already running can't be interrupted and are finished
+// before GetAllInventories returns.
+func GetAllInventories(client *http.Client, steamId steamid.SteamId, options *InventoryOptions, concurrency int) (GenericInventory, error) {
+	contexts, err := GetAppContexts(client, steamId)
+	if err != nil {
+		return nil, err
+	}
+	if concurrency <= 0 {
+		concurrency = defaultInventoryConcurrency
+	}
+
+	result := NewGenericInventory()
+	var (
+		mutex    sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	queue := make(chan AppContext)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for appContext := range queue {
+				inv, err := GetInventory(client, steamId, appContext.AppId, appContext.ContextId, options)
+				mutex.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+				} else {
+					result.Add(appContext.AppId, appContext.ContextId, inv)
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+	for _, appContext := range contexts {
+		mutex.Lock()
+		failed := firstErr != nil
+		mutex.Unlock()
+		if failed {
+			break
+		}
+		queue <- appContext
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
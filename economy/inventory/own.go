@@ -3,18 +3,24 @@ package inventory
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
+// Uses the deprecated /my/inventory/json endpoint, see GetInventory for its replacement.
 func GetPartialOwnInventory(client *http.Client, contextId uint64, appId uint32, start *uint, tradableOnly bool) (*PartialInventory, error) {
-	url := fmt.Sprintf("http://steamcommunity.com/my/inventory/json/%d/%d", appId, contextId)
+	params := url.Values{}
 	if tradableOnly {
-		url += "?trading=1"
+		params.Set("trading", "1")
 	}
 	if start != nil {
-		url += "&start=" + strconv.FormatUint(uint64(*start), 10)
+		params.Set("start", strconv.FormatUint(uint64(*start), 10))
 	}
-	req, err := http.NewRequest("GET", url, nil)
+	reqUrl := fmt.Sprintf("https://steamcommunity.com/my/inventory/json/%d/%d", appId, contextId)
+	if len(params) > 0 {
+		reqUrl += "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", reqUrl, nil)
 	if err != nil {
 		return nil, err
 	}
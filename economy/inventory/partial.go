@@ -44,7 +44,7 @@ func DoInventoryRequest(client *http.Client, req *http.Request) (*PartialInvento
 	if err != nil {
 		str := string(bytes)
 		if strings.Contains(str, "g_steamID = false;") && strings.Contains(str, "<title>Sign In</title>") {
-			err = errors.New("not logged in")
+			err = ErrNotLoggedIn
 		}
 		return nil, err
 	}
@@ -0,0 +1,246 @@
+/*
+Implements filtering, sorting and grouping of inventory items together with
+their descriptions.
+
+	items := query.From(inv, 730, 2).
+		Tradable().
+		Tag("Type", "Knife").
+		Where(func(e *query.Entry) bool { return e.HasTag("Exterior", "") }).
+		SortBy(query.ByName).
+		TradeItems()
+*/
+package query
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/vuquang23/go-steam/economy/inventory"
+	"github.com/vuquang23/go-steam/tradeoffer"
+)
+
+// An item joined with its description. Description is nil if the inventory
+// didn't contain one for the item.
+type Entry struct {
+	AppId       uint32
+	ContextId   uint64
+	Item        *inventory.Item
+	Description *inventory.Description
+}
+
+// Returns the whole stack as an item of a trade offer.
+func (e *Entry) TradeItem() tradeoffer.TradeItem {
+	return tradeoffer.TradeItem{
+		AppId:     e.AppId,
+		ContextId: e.ContextId,
+		AssetId:   e.Item.Id,
+		Amount:    e.Item.Amount,
+	}
+}
+
+// Category and name match either the internal or the localized name of a tag.
+// An empty name matches every tag of the category.
+func (e *Entry) HasTag(category string, name string) bool {
+	if e.Description == nil {
+		return false
+	}
+	for _, tag := range e.Description.Tags {
+		if tag.Category != category && tag.CategoryName != category {
+			continue
+		}
+		if name == "" || tag.InternalName == name || tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+type Filter func(e *Entry) bool
+
+// Query is an immutable selection of inventory entries. Every method returns
+// a new Query, so that a query can be reused as the base of others.
+type Query struct {
+	entries []*Entry
+	filters []Filter
+	less    func(a, b *Entry) bool
+	limit   int
+}
+
+// Starts a query over the items of an inventory of the given app and context.
+func From(inv *inventory.Inventory, appId uint32, contextId uint64) *Query {
+	q := &Query{entries: make([]*Entry, 0, len(inv.Items))}
+	q.entries = appendEntries(q.entries, inv, appId, contextId)
+	return q
+}
+
+// Starts a query over all inventories of a GenericInventory.
+func FromGeneric(inv inventory.GenericInventory) *Query {
+	q := new(Query)
+	for appId, contexts := range inv {
+		for contextId, i := range contexts {
+			q.entries = appendEntries(q.entries, i, appId, contextId)
+		}
+	}
+	return q
+}
+
+func appendEntries(entries []*Entry, inv *inventory.Inventory, appId uint32, contextId uint64) []*Entry {
+	for _, item := range inv.Items {
+		desc, _ := inv.Descriptions.Get(item.ClassId, item.InstanceId)
+		entries = append(entries, &Entry{appId, contextId, item, desc})
+	}
+	return entries
+}
+
+func (q *Query) with(f func(n *Query)) *Query {
+	n := *q
+	n.filters = append([]Filter(nil), q.filters...)
+	f(&n)
+	return &n
+}
+
+func (q *Query) Where(filter Filter) *Query {
+	return q.with(func(n *Query) {
+		n.filters = append(n.filters, filter)
+	})
+}
+
+func (q *Query) Tradable() *Query {
+	return q.Where(func(e *Entry) bool {
+		return e.Description != nil && bool(e.Description.Tradable)
+	})
+}
+
+func (q *Query) Marketable() *Query {
+	return q.Where(func(e *Entry) bool {
+		return e.Description != nil && bool(e.Description.Marketable)
+	})
+}
+
+// Keeps entries with a matching tag, see Entry.HasTag.
+func (q *Query) Tag(category string, name string) *Query {
+	return q.Where(func(e *Entry) bool {
+		return e.HasTag(category, name)
+	})
+}
+
+// Keeps entries whose name or market hash name matches.
+func (q *Query) Name(re *regexp.Regexp) *Query {
+	return q.Where(func(e *Entry) bool {
+		return e.Description != nil && (re.MatchString(e.Description.Name) || re.MatchString(e.Description.MarketHashName))
+	})
+}
+
+// Keeps entries that have the AppData key. An empty value matches any value.
+func (q *Query) AppData(key string, value string) *Query {
+	return q.Where(func(e *Entry) bool {
+		if e.Description == nil {
+			return false
+		}
+		v, ok := e.Description.AppData[key]
+		return ok && (value == "" || v == value)
+	})
+}
+
+// Sorts the results. The sort is stable, entries that compare equal keep
+// their position in the inventory.
+func (q *Query) SortBy(less func(a, b *Entry) bool) *Query {
+	return q.with(func(n *Query) {
+		n.less = less
+	})
+}
+
+// Limits the number of results, zero means no limit.
+func (q *Query) Limit(limit int) *Query {
+	return q.with(func(n *Query) {
+		n.limit = limit
+	})
+}
+
+func ByPos(a, b *Entry) bool {
+	if a.AppId != b.AppId {
+		return a.AppId < b.AppId
+	}
+	if a.ContextId != b.ContextId {
+		return a.ContextId < b.ContextId
+	}
+	return a.Item.Pos < b.Item.Pos
+}
+
+func ByName(a, b *Entry) bool {
+	return entryName(a) < entryName(b)
+}
+
+func entryName(e *Entry) string {
+	if e.Description == nil {
+		return ""
+	}
+	return e.Description.Name
+}
+
+// Returns the entries that pass all filters, sorted if SortBy was used and
+// otherwise in inventory order.
+func (q *Query) All() []*Entry {
+	var result []*Entry
+outer:
+	for _, e := range q.entries {
+		for _, f := range q.filters {
+			if !f(e) {
+				continue outer
+			}
+		}
+		result = append(result, e)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return ByPos(result[i], result[j])
+	})
+	if q.less != nil {
+		sort.SliceStable(result, func(i, j int) bool {
+			return q.less(result[i], result[j])
+		})
+	}
+	if q.limit > 0 && len(result) > q.limit {
+		result = result[:q.limit]
+	}
+	return result
+}
+
+// Returns nil if nothing matches.
+func (q *Query) First() *Entry {
+	result := q.Limit(1).All()
+	if len(result) == 0 {
+		return nil
+	}
+	return result[0]
+}
+
+func (q *Query) Count() int {
+	return len(q.All())
+}
+
+// Groups the results by a key, like the market hash name. Every group keeps the order of All.
+func (q *Query) GroupBy(key func(e *Entry) string) map[string][]*Entry {
+	groups := make(map[string][]*Entry)
+	for _, e := range q.All() {
+		k := key(e)
+		groups[k] = append(groups[k], e)
+	}
+	return groups
+}
+
+func ByMarketHashName(e *Entry) string {
+	if e.Description == nil {
+		return ""
+	}
+	return e.Description.MarketHashName
+}
+
+// Returns the results as items of a trade offer, see Entry.TradeItem.
+func (q *Query) TradeItems() []tradeoffer.TradeItem {
+	result := q.All()
+	items := make([]tradeoffer.TradeItem, len(result))
+	for i, e := range result {
+		items[i] = e.TradeItem()
+	}
+	return items
+}
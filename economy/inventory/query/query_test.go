@@ -0,0 +1,141 @@
+package query
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/vuquang23/go-steam/economy/inventory"
+)
+
+func testInventory() *inventory.Inventory {
+	return &inventory.Inventory{
+		Items: inventory.Items{
+			"1": {Id: 1, ClassId: 10, Amount: 1, Pos: 3},
+			"2": {Id: 2, ClassId: 20, Amount: 1, Pos: 1},
+			"3": {Id: 3, ClassId: 30, Amount: 1, Pos: 2},
+			"4": {Id: 4, ClassId: 40, Amount: 5, Pos: 4},
+			"5": {Id: 5, ClassId: 50, Amount: 1, Pos: 5},
+		},
+		Descriptions: inventory.Descriptions{
+			"10_0": {
+				ClassId: 10, Name: "Karambit", MarketHashName: "★ Karambit | Fade (Factory New)",
+				Tradable: true, Marketable: true,
+				Tags: []*inventory.Tag{
+					{InternalName: "CSGO_Type_Knife", Name: "Knife", Category: "Type", CategoryName: "Type"},
+					{InternalName: "WearCategory0", Name: "Factory New", Category: "Exterior", CategoryName: "Exterior"},
+				},
+			},
+			"20_0": {
+				ClassId: 20, Name: "AK-47", MarketHashName: "AK-47 | Redline (Field-Tested)",
+				Tradable: false, Marketable: true,
+				Tags: []*inventory.Tag{
+					{InternalName: "CSGO_Type_Rifle", Name: "Rifle", Category: "Type", CategoryName: "Type"},
+					{InternalName: "WearCategory2", Name: "Field-Tested", Category: "Exterior", CategoryName: "Exterior"},
+				},
+				AppData: map[string]string{"def_index": "7"},
+			},
+			"30_0": {
+				ClassId: 30, Name: "Bayonet", MarketHashName: "★ Bayonet",
+				Tradable: true, Marketable: false,
+				Tags: []*inventory.Tag{
+					{InternalName: "CSGO_Type_Knife", Name: "Messer", Category: "Type", CategoryName: "Typ"},
+				},
+				AppData: map[string]string{"def_index": "500"},
+			},
+			"40_0": {
+				ClassId: 40, Name: "AK-47", MarketHashName: "Sticker | Crown (Foil)",
+				Tradable: true, Marketable: true,
+			},
+		},
+	}
+}
+
+func ids(entries []*Entry) []uint64 {
+	result := make([]uint64, len(entries))
+	for i, e := range entries {
+		result[i] = e.Item.Id
+	}
+	return result
+}
+
+func TestQuery(t *testing.T) {
+	base := From(testInventory(), 730, 2)
+	for _, test := range []struct {
+		name     string
+		query    *Query
+		expected []uint64
+	}{
+		{"all in inventory order", base, []uint64{2, 3, 1, 4, 5}},
+		{"tradable", base.Tradable(), []uint64{3, 1, 4}},
+		{"marketable", base.Marketable(), []uint64{2, 1, 4}},
+		{"tradable and marketable", base.Tradable().Marketable(), []uint64{1, 4}},
+		{"tag by localized name", base.Tag("Type", "Knife"), []uint64{1}},
+		{"tag by internal name", base.Tag("Type", "CSGO_Type_Knife"), []uint64{3, 1}},
+		{"tag by category name", base.Tag("Typ", "Messer"), []uint64{3}},
+		{"any tag of a category", base.Tag("Exterior", ""), []uint64{2, 1}},
+		{"tag and tradable", base.Tag("Exterior", "").Tradable(), []uint64{1}},
+		{"name", base.Name(regexp.MustCompile(`^AK`)), []uint64{2, 4}},
+		{"market hash name", base.Name(regexp.MustCompile(`Fade`)), []uint64{1}},
+		{"app data key", base.AppData("def_index", ""), []uint64{2, 3}},
+		{"app data value", base.AppData("def_index", "500"), []uint64{3}},
+		{"where", base.Where(func(e *Entry) bool { return e.Item.Amount > 1 }), []uint64{4}},
+		{"nothing", base.Tradable().AppData("def_index", "7"), nil},
+		{"sort by name", base.SortBy(ByName), []uint64{5, 2, 4, 3, 1}},
+		{"sort by name after filter", base.Tradable().SortBy(ByName), []uint64{4, 3, 1}},
+		{"limit", base.Limit(2), []uint64{2, 3}},
+		{"limit after sort", base.SortBy(ByName).Limit(3), []uint64{5, 2, 4}},
+		{"limit larger than result", base.Tradable().Limit(10), []uint64{3, 1, 4}},
+		{"no limit", base.Limit(2).Limit(0), []uint64{2, 3, 1, 4, 5}},
+	} {
+		actual := ids(test.query.All())
+		if len(actual) == 0 {
+			actual = nil
+		}
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestQueryIsImmutable(t *testing.T) {
+	base := From(testInventory(), 730, 2).Tradable()
+	base.Marketable()
+	base.Limit(1)
+	if count := base.Count(); count != 3 {
+		t.Errorf("Expected 3 entries, got %d", count)
+	}
+}
+
+func TestQueryFirstAndGroupBy(t *testing.T) {
+	base := From(testInventory(), 730, 2)
+	if first := base.Tradable().SortBy(ByName).First(); first == nil || first.Item.Id != 4 {
+		t.Errorf("Expected item 4 first, got %v", first)
+	}
+	if first := base.AppData("missing", "").First(); first != nil {
+		t.Errorf("Expected nil, got %v", first)
+	}
+
+	groups := base.GroupBy(func(e *Entry) string { return entryName(e) })
+	if !reflect.DeepEqual(ids(groups["AK-47"]), []uint64{2, 4}) || !reflect.DeepEqual(ids(groups[""]), []uint64{5}) {
+		t.Errorf("Unexpected groups %v", groups)
+	}
+}
+
+func TestFromGenericSortsByApp(t *testing.T) {
+	inv := inventory.NewGenericInventory()
+	inv.Add(730, 2, testInventory())
+	inv.Add(440, 2, &inventory.Inventory{
+		Items:        inventory.Items{"9": {Id: 9, ClassId: 90, Amount: 1, Pos: 1}},
+		Descriptions: inventory.Descriptions{"90_0": {ClassId: 90, Name: "Mann Co. Supply Crate Key", Tradable: true}},
+	})
+
+	actual := ids(FromGeneric(inv).Tradable().All())
+	if expected := []uint64{9, 3, 1, 4}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+	items := FromGeneric(inv).Limit(1).TradeItems()
+	if len(items) != 1 || items[0].AppId != 440 || items[0].ContextId != 2 || items[0].AssetId != 9 || items[0].Amount != 1 {
+		t.Errorf("Unexpected trade items %+v", items)
+	}
+}
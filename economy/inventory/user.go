@@ -0,0 +1,239 @@
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/vuquang23/go-steam/jsont"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+const (
+	defaultInventoryCount      = 2000
+	defaultInventoryMaxRetries = 3
+	defaultInventoryRetryDelay = 10 * time.Second
+)
+
+type InventoryOptions struct {
+	// Language of the descriptions, like "english" or "german". Steam picks one if empty.
+	Language string
+	// Items per page. Steam allows at most 2000, which is also the default.
+	Count uint
+	// How often a request is repeated after 429 Too Many Requests. Defaults to 3, negative disables retries.
+	MaxRetries int
+	// Wait before the first retry, doubled for every further one. Defaults to 10 seconds.
+	RetryDelay time.Duration
+}
+
+// A page of an inventory as sent by the /inventory endpoint.
+type InventoryPage struct {
+	Inventory
+	TotalInventoryCount uint
+	More                bool
+	// Pass as startAssetId to get the next page.
+	LastAssetId uint64
+}
+
+type inventoryPageResponse struct {
+	Success             jsont.UintBool          `json:"success"`
+	Error               string                  `json:"error"`
+	Assets              []*inventoryAsset       `json:"assets"`
+	Descriptions        []*inventoryDescription `json:"descriptions"`
+	TotalInventoryCount uint                    `json:"total_inventory_count"`
+	MoreItems           jsont.UintBool          `json:"more_items"`
+	LastAssetId         uint64                  `json:"last_assetid,string"`
+}
+
+type inventoryAsset struct {
+	AppId      uint32 `json:"appid"`
+	ContextId  uint64 `json:"contextid,string"`
+	AssetId    uint64 `json:"assetid,string"`
+	ClassId    uint64 `json:"classid,string"`
+	InstanceId uint64 `json:"instanceid,string"`
+	Amount     uint64 `json:"amount,string"`
+}
+
+// Same as Description, but numbers aren't quoted and tags are named differently.
+type inventoryDescription struct {
+	AppId      uint32 `json:"appid"`
+	ClassId    uint64 `json:"classid,string"`
+	InstanceId uint64 `json:"instanceid,string"`
+
+	IconUrl      string `json:"icon_url"`
+	IconUrlLarge string `json:"icon_url_large"`
+
+	Name            string `json:"name"`
+	MarketName      string `json:"market_name"`
+	MarketHashName  string `json:"market_hash_name"`
+	NameColor       string `json:"name_color"`
+	BackgroundColor string `json:"background_color"`
+	Type            string `json:"type"`
+
+	Tradable                  jsont.UintBool `json:"tradable"`
+	Marketable                jsont.UintBool `json:"marketable"`
+	Commodity                 jsont.UintBool `json:"commodity"`
+	MarketTradableRestriction uint32         `json:"market_tradable_restriction"`
+
+	Descriptions DescriptionLines `json:"descriptions"`
+	Actions      []*Action        `json:"actions"`
+	Tags         []*struct {
+		InternalName          string `json:"internal_name"`
+		Category              string `json:"category"`
+		LocalizedTagName      string `json:"localized_tag_name"`
+		LocalizedCategoryName string `json:"localized_category_name"`
+	} `json:"tags"`
+}
+
+func (d *inventoryDescription) toDescription() *Description {
+	desc := &Description{
+		AppId:                     d.AppId,
+		ClassId:                   d.ClassId,
+		InstanceId:                d.InstanceId,
+		IconUrl:                   d.IconUrl,
+		IconUrlLarge:              d.IconUrlLarge,
+		Name:                      d.Name,
+		MarketName:                d.MarketName,
+		MarketHashName:            d.MarketHashName,
+		NameColor:                 d.NameColor,
+		BackgroundColor:           d.BackgroundColor,
+		Type:                      d.Type,
+		Tradable:                  d.Tradable,
+		Marketable:                d.Marketable,
+		Commodity:                 d.Commodity,
+		MarketTradableRestriction: d.MarketTradableRestriction,
+		Descriptions:              d.Descriptions,
+		Actions:                   d.Actions,
+	}
+	for _, tag := range d.Tags {
+		desc.Tags = append(desc.Tags, &Tag{
+			InternalName: tag.InternalName,
+			Name:         tag.LocalizedTagName,
+			Category:     tag.Category,
+			CategoryName: tag.LocalizedCategoryName,
+		})
+	}
+	return desc
+}
+
+// Gets a page of the inventory of any user, starting after startAssetId or
+// from the beginning if it is zero. Our own inventory includes items that
+// aren't visible to others.
+func GetPartialInventory(client *http.Client, steamId steamid.SteamId, appId uint32, contextId uint64, startAssetId uint64, options *InventoryOptions) (*InventoryPage, error) {
+	if options == nil {
+		options = new(InventoryOptions)
+	}
+	count := options.Count
+	if count == 0 {
+		count = defaultInventoryCount
+	}
+	params := url.Values{
+		"count": {strconv.FormatUint(uint64(count), 10)},
+	}
+	if options.Language != "" {
+		params.Set("l", options.Language)
+	}
+	if startAssetId != 0 {
+		params.Set("start_assetid", strconv.FormatUint(startAssetId, 10))
+	}
+	reqUrl := fmt.Sprintf("https://steamcommunity.com/inventory/%d/%d/%d?%s", steamId, appId, contextId, params.Encode())
+
+	maxRetries := options.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultInventoryMaxRetries
+	}
+	delay := options.RetryDelay
+	if delay == 0 {
+		delay = defaultInventoryRetryDelay
+	}
+	for retry := 0; ; retry++ {
+		page, err := doInventoryPageRequest(client, reqUrl)
+		if err != ErrRateLimited || retry >= maxRetries {
+			return page, err
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func doInventoryPageRequest(client *http.Client, reqUrl string) (*InventoryPage, error) {
+	req, err := http.NewRequest("GET", reqUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return nil, ErrRateLimited
+	case http.StatusForbidden:
+		return nil, ErrPrivateInventory
+	default:
+		return nil, fmt.Errorf("inventory request failed: status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var res inventoryPageResponse
+	if err = json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return nil, fmt.Errorf("inventory request failed: %s", res.Error)
+	}
+
+	page := &InventoryPage{
+		Inventory: Inventory{
+			Items:        make(Items, len(res.Assets)),
+			Currencies:   make(Currencies),
+			Descriptions: make(Descriptions, len(res.Descriptions)),
+		},
+		TotalInventoryCount: res.TotalInventoryCount,
+		More:                bool(res.MoreItems),
+		LastAssetId:         res.LastAssetId,
+	}
+	for i, asset := range res.Assets {
+		page.Items[strconv.FormatUint(asset.AssetId, 10)] = &Item{
+			Id:         asset.AssetId,
+			ClassId:    asset.ClassId,
+			InstanceId: asset.InstanceId,
+			Amount:     asset.Amount,
+			Pos:        uint32(i + 1),
+		}
+	}
+	for _, desc := range res.Descriptions {
+		page.Descriptions[fmt.Sprintf("%d_%d", desc.ClassId, desc.InstanceId)] = desc.toDescription()
+	}
+	return page, nil
+}
+
+// Gets the whole inventory of any user, following the pages until the last one.
+func GetInventory(client *http.Client, steamId steamid.SteamId, appId uint32, contextId uint64, options *InventoryOptions) (*Inventory, error) {
+	page, err := GetPartialInventory(client, steamId, appId, contextId, 0, options)
+	if err != nil {
+		return nil, err
+	}
+	result := &page.Inventory
+	for pos := uint32(len(page.Items)); page.More; pos += uint32(len(page.Items)) {
+		page, err = GetPartialInventory(client, steamId, appId, contextId, page.LastAssetId, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			item.Pos += pos
+		}
+		result = Merge(result, &page.Inventory)
+	}
+	return result, nil
+}
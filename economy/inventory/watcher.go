@@ -0,0 +1,149 @@
+package inventory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Emitted when a watched inventory differs from its previous state.
+type InventoryChangedEvent struct {
+	Key       CacheKey
+	Inventory *Inventory
+	Diff      *InventoryDiff
+}
+
+// Watcher refreshes a set of inventories through a Cache and emits an
+// InventoryChangedEvent for every inventory that changed. An inventory that
+// wasn't cached yet only becomes the baseline for later refreshes, its items
+// aren't reported as added. Always poll events from the channel returned by
+// Events() or refreshing will stop.
+//
+// Inventories are refreshed every interval, if one is set, and whenever Refresh
+// is called. Call Refresh when a trade offer was accepted, for example:
+//
+//	if e, ok := event.(*tradeoffer.OfferChangedEvent); ok && e.New == tradeoffer.TradeOfferState_Accepted {
+//		watcher.Refresh()
+//	}
+type Watcher struct {
+	cache    *Cache
+	interval time.Duration
+
+	events  chan interface{}
+	trigger chan struct{}
+
+	mutex sync.Mutex
+	keys  map[CacheKey]struct{}
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// Zero interval refreshes only on Refresh.
+func NewWatcher(cache *Cache, interval time.Duration) *Watcher {
+	return &Watcher{
+		cache:    cache,
+		interval: interval,
+		events:   make(chan interface{}, 3),
+		trigger:  make(chan struct{}, 1),
+		keys:     make(map[CacheKey]struct{}),
+	}
+}
+
+// Get the event channel. All events are pointers, except for errors.
+// It is never closed.
+func (w *Watcher) Events() <-chan interface{} {
+	return w.events
+}
+
+func (w *Watcher) Watch(steamId steamid.SteamId, appId uint32, contextId uint64) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.keys[CacheKey{steamId, appId, contextId}] = struct{}{}
+}
+
+func (w *Watcher) Unwatch(steamId steamid.SteamId, appId uint32, contextId uint64) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	delete(w.keys, CacheKey{steamId, appId, contextId})
+}
+
+// Starts refreshing in a new goroutine. Calling Start on a running watcher does nothing.
+func (w *Watcher) Start() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.stop != nil {
+		return
+	}
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	go w.loop(w.stop, w.done)
+}
+
+// Stops refreshing and waits for the current refresh to finish.
+func (w *Watcher) Stop() {
+	w.mutex.Lock()
+	stop, done := w.stop, w.done
+	w.stop, w.done = nil, nil
+	w.mutex.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Requests a refresh of all watched inventories.
+func (w *Watcher) Refresh() {
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watcher) loop(stop, done chan struct{}) {
+	defer close(done)
+	var tick <-chan time.Time
+	if w.interval > 0 {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-stop:
+			return
+		case <-tick:
+		case <-w.trigger:
+		}
+		w.refresh(stop)
+	}
+}
+
+func (w *Watcher) refresh(stop chan struct{}) {
+	w.mutex.Lock()
+	keys := make([]CacheKey, 0, len(w.keys))
+	for key := range w.keys {
+		keys = append(keys, key)
+	}
+	w.mutex.Unlock()
+
+	for _, key := range keys {
+		inv, diff, cached, err := w.cache.refresh(key)
+		if err != nil {
+			w.emit(stop, err)
+			continue
+		}
+		// the first state of an inventory is the baseline, not a change
+		if cached && !diff.Empty() {
+			w.emit(stop, &InventoryChangedEvent{key, inv, diff})
+		}
+	}
+}
+
+func (w *Watcher) emit(stop chan struct{}, event interface{}) {
+	select {
+	case w.events <- event:
+	case <-stop:
+	}
+}
@@ -2,6 +2,7 @@ package steam
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/vuquang23/go-steam/protocol"
 	"github.com/vuquang23/go-steam/protocol/gamecoordinator"
@@ -13,6 +14,9 @@ import (
 type GameCoordinator struct {
 	client   *Client
 	handlers []GCPacketHandler
+
+	mutex       sync.RWMutex
+	gamesPlayed []uint64
 }
 
 func newGC(client *Client) *GameCoordinator {
@@ -74,7 +78,18 @@ func (g *GameCoordinator) SetGamesPlayed(appIds ...uint64) {
 		})
 	}
 
+	g.mutex.Lock()
+	g.gamesPlayed = append([]uint64(nil), appIds...)
+	g.mutex.Unlock()
+
 	g.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientGamesPlayed, &protobuf.CMsgClientGamesPlayed{
 		GamesPlayed: games,
 	}))
 }
+
+// Returns the games we were set in with SetGamesPlayed
+func (g *GameCoordinator) GamesPlayed() []uint64 {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return append([]uint64(nil), g.gamesPlayed...)
+}
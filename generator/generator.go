@@ -119,8 +119,10 @@ var clientProtoFiles = map[string]string{
 
 	"steammessages_unified_base.steamclient.proto":      "unified/base.pb.go",
 	"steammessages_cloud.steamclient.proto":             "unified/cloud.pb.go",
+	"steammessages_chat.steamclient.proto":              "unified/chat.pb.go",
 	"steammessages_credentials.steamclient.proto":       "unified/credentials.pb.go",
 	"steammessages_deviceauth.steamclient.proto":        "unified/deviceauth.pb.go",
+	"steammessages_friendmessages.steamclient.proto":    "unified/friendmessages.pb.go",
 	"steammessages_gamenotifications.steamclient.proto": "unified/gamenotifications.pb.go",
 	"steammessages_offline.steamclient.proto":           "unified/offline.pb.go",
 	"steammessages_parental.steamclient.proto":          "unified/parental.pb.go",
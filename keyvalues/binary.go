@@ -0,0 +1,256 @@
+package keyvalues
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"unicode/utf16"
+)
+
+// Reads binary KeyValues one top-level node at a time
+type BinaryDecoder struct {
+	r io.ByteReader
+}
+
+// Returns a decoder that reads from r. If r doesn't implement io.ByteReader,
+// it is buffered and the decoder may read more than it needs.
+func NewBinaryDecoder(r io.Reader) *BinaryDecoder {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &BinaryDecoder{r: br}
+}
+
+func isEnd(t Type) bool {
+	return t == TypeEnd || t == TypeAlternateEnd
+}
+
+// Returns the next top-level node, or io.EOF if the input or the
+// document ended.
+func (d *BinaryDecoder) Decode() (*KeyValue, error) {
+	typ, err := d.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if isEnd(Type(typ)) {
+		return nil, io.EOF
+	}
+	return d.decodeNode(Type(typ), 0)
+}
+
+// Sections can't be nested deeper, so that bad input doesn't overflow the stack
+const maxDepth = 512
+
+func (d *BinaryDecoder) decodeNode(typ Type, depth int) (*KeyValue, error) {
+	key, err := d.readString()
+	if err != nil {
+		return nil, err
+	}
+	kv := &KeyValue{Key: key, Type: typ}
+	switch typ {
+	case TypeNone:
+		if depth >= maxDepth {
+			return nil, errors.New("keyvalues: sections nested too deep")
+		}
+		for {
+			b, err := d.r.ReadByte()
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			if isEnd(Type(b)) {
+				return kv, nil
+			}
+			child, err := d.decodeNode(Type(b), depth+1)
+			if err != nil {
+				return nil, err
+			}
+			kv.Children = append(kv.Children, child)
+		}
+	case TypeString:
+		kv.Value, err = d.readString()
+	case TypeWideString:
+		kv.Value, err = d.readWideString()
+	case TypeInt32:
+		var v uint32
+		v, err = d.readUint32()
+		kv.Value = int32(v)
+	case TypeFloat32:
+		var v uint32
+		v, err = d.readUint32()
+		kv.Value = math.Float32frombits(v)
+	case TypePointer, TypeColor:
+		kv.Value, err = d.readUint32()
+	case TypeUint64:
+		kv.Value, err = d.readUint64()
+	case TypeInt64:
+		var v uint64
+		v, err = d.readUint64()
+		kv.Value = int64(v)
+	default:
+		return nil, fmt.Errorf("keyvalues: unknown type %d of %q", typ, key)
+	}
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return kv, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (d *BinaryDecoder) readString() (string, error) {
+	var buf []byte
+	for {
+		b, err := d.r.ReadByte()
+		if err != nil {
+			return "", unexpectedEOF(err)
+		}
+		if b == 0 {
+			return string(buf), nil
+		}
+		buf = append(buf, b)
+	}
+}
+
+func (d *BinaryDecoder) readWideString() (string, error) {
+	var units []uint16
+	for {
+		lo, err := d.r.ReadByte()
+		if err != nil {
+			return "", unexpectedEOF(err)
+		}
+		hi, err := d.r.ReadByte()
+		if err != nil {
+			return "", unexpectedEOF(err)
+		}
+		unit := uint16(lo) | uint16(hi)<<8
+		if unit == 0 {
+			return string(utf16.Decode(units)), nil
+		}
+		units = append(units, unit)
+	}
+}
+
+func (d *BinaryDecoder) readUint32() (uint32, error) {
+	var buf [4]byte
+	for i := range buf {
+		b, err := d.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		buf[i] = b
+	}
+	return binary.LittleEndian.Uint32(buf[:]), nil
+}
+
+func (d *BinaryDecoder) readUint64() (uint64, error) {
+	lo, err := d.readUint32()
+	if err != nil {
+		return 0, err
+	}
+	hi, err := d.readUint32()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(lo) | uint64(hi)<<32, nil
+}
+
+// Reads a binary document with a single top-level node, like the ones Steam
+// sends in messages. The end of the document is consumed as well.
+func ReadBinary(r io.Reader) (*KeyValue, error) {
+	d := NewBinaryDecoder(r)
+	kv, err := d.Decode()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return nil, err
+	}
+	b, err := d.r.ReadByte()
+	if err != nil && err != io.EOF {
+		return nil, err
+	} else if err == nil && !isEnd(Type(b)) {
+		return nil, fmt.Errorf("keyvalues: expected the end of the document, got type %d", b)
+	}
+	return kv, nil
+}
+
+// Writes the node as a binary document, which is followed by TypeEnd
+func (kv *KeyValue) WriteBinary(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if err := writeBinaryNode(bw, kv); err != nil {
+		return err
+	}
+	bw.WriteByte(byte(TypeEnd))
+	return bw.Flush()
+}
+
+func writeBinaryNode(w *bufio.Writer, kv *KeyValue) error {
+	w.WriteByte(byte(kv.Type))
+	writeString(w, kv.Key)
+	var err error
+	switch kv.Type {
+	case TypeNone:
+		for _, child := range kv.Children {
+			if err = writeBinaryNode(w, child); err != nil {
+				return err
+			}
+		}
+		return w.WriteByte(byte(TypeEnd))
+	case TypeString:
+		writeString(w, kv.String())
+	case TypeWideString:
+		for _, unit := range utf16.Encode([]rune(kv.String())) {
+			binary.Write(w, binary.LittleEndian, unit)
+		}
+		_, err = w.Write([]byte{0, 0})
+	case TypeInt32:
+		var v int64
+		v, err = kv.Int64()
+		if err == nil {
+			err = binary.Write(w, binary.LittleEndian, int32(v))
+		}
+	case TypeFloat32:
+		var v float64
+		v, err = kv.Float64()
+		if err == nil {
+			err = binary.Write(w, binary.LittleEndian, float32(v))
+		}
+	case TypePointer, TypeColor:
+		var v uint64
+		v, err = kv.Uint64()
+		if err == nil {
+			err = binary.Write(w, binary.LittleEndian, uint32(v))
+		}
+	case TypeUint64:
+		var v uint64
+		v, err = kv.Uint64()
+		if err == nil {
+			err = binary.Write(w, binary.LittleEndian, v)
+		}
+	case TypeInt64:
+		var v int64
+		v, err = kv.Int64()
+		if err == nil {
+			err = binary.Write(w, binary.LittleEndian, v)
+		}
+	default:
+		return fmt.Errorf("keyvalues: can't write type %d of %q", kv.Type, kv.Key)
+	}
+	if err != nil {
+		return fmt.Errorf("keyvalues: can't write %q as %v: %v", kv.Key, kv.Type, err)
+	}
+	return nil
+}
+
+func writeString(w *bufio.Writer, s string) {
+	w.WriteString(s)
+	w.WriteByte(0)
+}
@@ -0,0 +1,284 @@
+/*
+Reads and writes Valve's KeyValues format, also known as VDF, in its binary
+and text form.
+
+KeyValues are a tree of nodes with a key each. A node either has a value or
+is a section of other nodes:
+
+	"RP"
+	{
+		"status"	"Processing 3 trades"
+		"steam_display"	"#Status"
+	}
+
+Text values are always strings, binary values are typed, see Type. Both are
+read into a *KeyValue:
+
+	kv, err := keyvalues.ReadText(file)
+	status := kv.Get("status").String()
+
+Large inputs can be streamed with a BinaryDecoder or TextDecoder, which return
+one top-level node at a time. Nodes can also be unmarshaled into structs,
+see Unmarshal.
+*/
+package keyvalues
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var errNotFound = errors.New("keyvalues: node not found")
+
+// The type of a node, which is how its value is stored in the binary format
+type Type byte
+
+const (
+	TypeNone       Type = 0 // a section of other nodes
+	TypeString     Type = 1
+	TypeInt32      Type = 2
+	TypeFloat32    Type = 3
+	TypePointer    Type = 4 // a 32 bit pointer, stored as uint32
+	TypeWideString Type = 5 // an UTF-16 string
+	TypeColor      Type = 6 // a RGBA color, stored as uint32
+	TypeUint64     Type = 7
+	TypeEnd        Type = 8 // ends a section
+	TypeInt64      Type = 10
+	// Ends a section like TypeEnd, used by some files
+	TypeAlternateEnd Type = 11
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeNone:
+		return "None"
+	case TypeString:
+		return "String"
+	case TypeInt32:
+		return "Int32"
+	case TypeFloat32:
+		return "Float32"
+	case TypePointer:
+		return "Pointer"
+	case TypeWideString:
+		return "WideString"
+	case TypeColor:
+		return "Color"
+	case TypeUint64:
+		return "Uint64"
+	case TypeEnd:
+		return "End"
+	case TypeInt64:
+		return "Int64"
+	case TypeAlternateEnd:
+		return "AlternateEnd"
+	}
+	return "Type(" + strconv.Itoa(int(t)) + ")"
+}
+
+// A node of a KeyValues tree.
+//
+// Value holds a string for TypeString and TypeWideString, an int32,
+// float32, uint64 or int64 for the number types of the same name and an
+// uint32 for TypePointer and TypeColor. Sections have Children and no Value.
+type KeyValue struct {
+	Key      string
+	Type     Type
+	Value    interface{}
+	Children []*KeyValue
+}
+
+// Returns a node with the given value, its type is taken from the Go type:
+// string, int32, float32, uint64 or int64. Other integers are stored as
+// int32 or int64, depending on their size.
+func New(key string, value interface{}) *KeyValue {
+	kv := &KeyValue{Key: key}
+	switch v := value.(type) {
+	case string:
+		kv.Type, kv.Value = TypeString, v
+	case int32:
+		kv.Type, kv.Value = TypeInt32, v
+	case float32:
+		kv.Type, kv.Value = TypeFloat32, v
+	case float64:
+		kv.Type, kv.Value = TypeFloat32, float32(v)
+	case uint64:
+		kv.Type, kv.Value = TypeUint64, v
+	case int64:
+		kv.Type, kv.Value = TypeInt64, v
+	case int:
+		kv.Type, kv.Value = TypeInt64, int64(v)
+	case int8:
+		kv.Type, kv.Value = TypeInt32, int32(v)
+	case int16:
+		kv.Type, kv.Value = TypeInt32, int32(v)
+	case uint8:
+		kv.Type, kv.Value = TypeInt32, int32(v)
+	case uint16:
+		kv.Type, kv.Value = TypeInt32, int32(v)
+	case uint32:
+		kv.Type, kv.Value = TypeInt64, int64(v)
+	case bool:
+		var n int32
+		if v {
+			n = 1
+		}
+		kv.Type, kv.Value = TypeInt32, n
+	default:
+		kv.Type, kv.Value = TypeString, fmt.Sprint(v)
+	}
+	return kv
+}
+
+// Returns a section with the given children
+func NewSection(key string, children ...*KeyValue) *KeyValue {
+	return &KeyValue{Key: key, Type: TypeNone, Children: children}
+}
+
+// Returns a section with a string node for each entry of the map, sorted by key
+func NewStringSection(key string, values map[string]string) *KeyValue {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	kv := NewSection(key)
+	for _, k := range keys {
+		kv.Add(New(k, values[k]))
+	}
+	return kv
+}
+
+// Appends a child to a section and returns the section
+func (kv *KeyValue) Add(child *KeyValue) *KeyValue {
+	kv.Children = append(kv.Children, child)
+	return kv
+}
+
+// Whether the node is a section
+func (kv *KeyValue) IsSection() bool {
+	return kv != nil && kv.Type == TypeNone
+}
+
+// Returns the first child with the given key, which is compared case
+// insensitively like Steam does, or nil.
+func (kv *KeyValue) Child(key string) *KeyValue {
+	if kv == nil {
+		return nil
+	}
+	for _, child := range kv.Children {
+		if strings.EqualFold(child.Key, key) {
+			return child
+		}
+	}
+	return nil
+}
+
+// Follows the path of keys through the tree and returns the node at its
+// end, or nil if it doesn't exist. All methods can be called on nil, so
+// lookups can be chained:
+//
+//	name := kv.Get("appinfo", "common", "name").String()
+func (kv *KeyValue) Get(path ...string) *KeyValue {
+	for _, key := range path {
+		kv = kv.Child(key)
+	}
+	return kv
+}
+
+// Returns the value formatted as a string, or "" for sections and nil
+func (kv *KeyValue) String() string {
+	if kv == nil || kv.Value == nil {
+		return ""
+	}
+	switch v := kv.Value.(type) {
+	case string:
+		return v
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	}
+	return fmt.Sprint(kv.Value)
+}
+
+// Returns the value as an int64, parsing strings
+func (kv *KeyValue) Int64() (int64, error) {
+	if kv == nil {
+		return 0, errNotFound
+	}
+	switch v := kv.Value.(type) {
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case float32:
+		return int64(v), nil
+	}
+	return strconv.ParseInt(kv.String(), 10, 64)
+}
+
+// Returns the value as an uint64, parsing strings
+func (kv *KeyValue) Uint64() (uint64, error) {
+	if kv == nil {
+		return 0, errNotFound
+	}
+	switch v := kv.Value.(type) {
+	case int32:
+		return uint64(v), nil
+	case int64:
+		return uint64(v), nil
+	case uint32:
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case float32:
+		return uint64(v), nil
+	}
+	return strconv.ParseUint(kv.String(), 10, 64)
+}
+
+// Returns the value as a float64, parsing strings
+func (kv *KeyValue) Float64() (float64, error) {
+	if kv == nil {
+		return 0, errNotFound
+	}
+	if v, ok := kv.Value.(float32); ok {
+		return float64(v), nil
+	}
+	return strconv.ParseFloat(kv.String(), 64)
+}
+
+// Returns whether the value is a number other than zero, like Steam
+// stores booleans
+func (kv *KeyValue) Bool() bool {
+	n, err := kv.Int64()
+	return err == nil && n != 0
+}
+
+// Returns the values of the children that aren't sections
+func (kv *KeyValue) StringMap() map[string]string {
+	values := make(map[string]string)
+	if kv == nil {
+		return values
+	}
+	for _, child := range kv.Children {
+		if !child.IsSection() {
+			values[child.Key] = child.String()
+		}
+	}
+	return values
+}
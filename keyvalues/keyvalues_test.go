@@ -0,0 +1,206 @@
+package keyvalues
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	kv := NewSection("root",
+		New("string", "hello"),
+		&KeyValue{Key: "wstring", Type: TypeWideString, Value: "héllo"},
+		New("int32", int32(-42)),
+		New("float", float32(1.5)),
+		&KeyValue{Key: "pointer", Type: TypePointer, Value: uint32(1234)},
+		&KeyValue{Key: "color", Type: TypeColor, Value: uint32(0xFF00FF00)},
+		New("uint64", uint64(76561198006409530)),
+		New("int64", int64(-1)),
+		NewSection("nested", New("key", "value")),
+	)
+	buf := new(bytes.Buffer)
+	if err := kv.WriteBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("trailing")
+	read, err := ReadBinary(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "trailing" {
+		t.Fatalf("Read too much, %q is left", buf.String())
+	}
+	if len(read.Children) != len(kv.Children) {
+		t.Fatalf("Expected %d children, got %d", len(kv.Children), len(read.Children))
+	}
+	for i, child := range kv.Children {
+		got := read.Children[i]
+		if got.Key != child.Key || got.Type != child.Type || got.Value != child.Value {
+			t.Errorf("Expected %+v, got %+v", child, got)
+		}
+	}
+	if s := read.Get("nested", "key").String(); s != "value" {
+		t.Errorf("Expected nested value, got %q", s)
+	}
+}
+
+func TestBinaryDecoderStreams(t *testing.T) {
+	buf := new(bytes.Buffer)
+	New("a", "1").WriteBinary(buf)
+	New("b", "2").WriteBinary(buf)
+	d := NewBinaryDecoder(io.MultiReader(buf)) // not an io.ByteReader
+	first, err := d.Decode()
+	if err != nil || first.Key != "a" {
+		t.Fatalf("Expected a, got %v, %v", first, err)
+	}
+	if _, err = d.Decode(); err != io.EOF {
+		t.Fatalf("Expected the end of the first document, got %v", err)
+	}
+	second, err := d.Decode()
+	if err != nil || second.Key != "b" {
+		t.Fatalf("Expected b, got %v, %v", second, err)
+	}
+	if _, err = d.Decode(); err != io.EOF {
+		t.Fatalf("Expected io.EOF, got %v", err)
+	}
+	if _, err = d.Decode(); err != io.EOF {
+		t.Fatalf("Expected io.EOF, got %v", err)
+	}
+}
+
+func TestReadBinaryTruncated(t *testing.T) {
+	data, _ := marshalBinary(NewSection("root", New("uint64", uint64(1))))
+	for i := 0; i < len(data)-1; i++ {
+		if _, err := ReadBinary(bytes.NewReader(data[:i])); err == nil {
+			t.Fatalf("Expected an error for %d of %d bytes", i, len(data))
+		}
+	}
+}
+
+const textDocument = `// a comment
+"AppState"
+{
+	"appid"		"440"
+	"name"		"Team Fortress 2"
+	"path"		"C:\\Program Files\\Steam"
+	"quote"		"say \"hi\""
+	"enabled"	"1"
+	unquoted	value
+	"windows"	"yes"	[$WIN32]
+	"UserConfig"
+	{
+		"language"		"english"
+	}
+	"InstalledDepots"
+	{
+		"441"	{ "manifest" "123" }
+		"232251"	{ "manifest" "456" }
+	}
+}
+`
+
+func TestReadText(t *testing.T) {
+	kv, err := ReadText(strings.NewReader(textDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, expected := range map[string]string{
+		"appid":    "440",
+		"path":     `C:\Program Files\Steam`,
+		"quote":    `say "hi"`,
+		"unquoted": "value",
+		"windows":  "yes",
+	} {
+		if s := kv.Get(key).String(); s != expected {
+			t.Errorf("Expected %q for %s, got %q", expected, key, s)
+		}
+	}
+	if s := kv.Get("userconfig", "LANGUAGE").String(); s != "english" {
+		t.Errorf("Expected case insensitive keys, got %q", s)
+	}
+
+	buf := new(bytes.Buffer)
+	if err = kv.WriteText(buf); err != nil {
+		t.Fatal(err)
+	}
+	again, err := ReadText(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := again.Get("quote").String(); s != `say "hi"` {
+		t.Errorf("Text didn't round trip, got %q", s)
+	}
+}
+
+func TestReadTextErrors(t *testing.T) {
+	for _, s := range []string{
+		``,
+		`"key"`,
+		`"key" {`,
+		`"key" "unterminated`,
+		`"key" { "a" }`,
+		`}`,
+	} {
+		if _, err := ReadText(strings.NewReader(s)); err == nil {
+			t.Errorf("Expected an error for %q", s)
+		}
+	}
+}
+
+type depot struct {
+	Manifest uint64
+}
+
+type appState struct {
+	AppId   uint32 `kv:"appid"`
+	Name    string `kv:"name"`
+	Enabled bool   `kv:"enabled"`
+	Missing string `kv:"missing"`
+	Ignored string `kv:"-"`
+	Config  struct {
+		Language string
+	} `kv:"UserConfig"`
+	Depots map[string]depot `kv:"InstalledDepots"`
+	List   []*depot         `kv:"InstalledDepots"`
+	Raw    *KeyValue        `kv:"UserConfig"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	kv, err := ReadText(strings.NewReader(textDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var app appState
+	if err = Unmarshal(kv, &app); err != nil {
+		t.Fatal(err)
+	}
+	if app.AppId != 440 || app.Name != "Team Fortress 2" || !app.Enabled {
+		t.Errorf("Unexpected app %+v", app)
+	}
+	if app.Config.Language != "english" || app.Raw.Get("language").String() != "english" {
+		t.Errorf("Unexpected config %+v", app.Config)
+	}
+	if len(app.Depots) != 2 || app.Depots["232251"].Manifest != 456 {
+		t.Errorf("Unexpected depots %+v", app.Depots)
+	}
+	if len(app.List) != 2 || app.List[0].Manifest != 123 {
+		t.Errorf("Unexpected depot list %+v", app.List)
+	}
+
+	var overflow struct {
+		AppId uint8 `kv:"appid"`
+	}
+	if err = Unmarshal(kv, &overflow); err == nil {
+		t.Error("Expected an overflow error")
+	}
+	if err = Unmarshal(kv, app); err == nil {
+		t.Error("Expected an error for a non-pointer")
+	}
+}
+
+func marshalBinary(kv *KeyValue) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	err := kv.WriteBinary(buf)
+	return buf.Bytes(), err
+}
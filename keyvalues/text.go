@@ -0,0 +1,263 @@
+package keyvalues
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Reads text KeyValues one top-level node at a time
+type TextDecoder struct {
+	r    *bufio.Reader
+	line int
+	// a token that was read ahead
+	peeked *token
+}
+
+// Returns a decoder that reads from r
+func NewTextDecoder(r io.Reader) *TextDecoder {
+	return &TextDecoder{r: bufio.NewReader(r), line: 1}
+}
+
+type tokenKind int
+
+const (
+	tokenString tokenKind = iota
+	tokenOpen
+	tokenClose
+	tokenCondition // like [$WIN32], which is ignored
+	tokenEOF
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// Returns the next top-level node, or io.EOF if the input ended.
+func (d *TextDecoder) Decode() (*KeyValue, error) {
+	t, err := d.next()
+	if err != nil {
+		return nil, err
+	}
+	switch t.kind {
+	case tokenEOF:
+		return nil, io.EOF
+	case tokenString:
+		return d.decodeNode(t.value, 0)
+	}
+	return nil, d.errorf("expected a key")
+}
+
+func (d *TextDecoder) decodeNode(key string, depth int) (*KeyValue, error) {
+	t, err := d.nextSkippingConditions()
+	if err != nil {
+		return nil, err
+	}
+	switch t.kind {
+	case tokenString:
+		kv := &KeyValue{Key: key, Type: TypeString, Value: t.value}
+		return kv, d.skipCondition()
+	case tokenOpen:
+		if depth >= maxDepth {
+			return nil, d.errorf("sections nested too deep")
+		}
+		kv := NewSection(key)
+		for {
+			t, err := d.next()
+			if err != nil {
+				return nil, err
+			}
+			switch t.kind {
+			case tokenClose:
+				return kv, d.skipCondition()
+			case tokenString:
+				child, err := d.decodeNode(t.value, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				kv.Children = append(kv.Children, child)
+			case tokenEOF:
+				return nil, d.errorf("unexpected end of input in %q", key)
+			default:
+				return nil, d.errorf("expected a key or } in %q", key)
+			}
+		}
+	case tokenEOF:
+		return nil, d.errorf("unexpected end of input after %q", key)
+	}
+	return nil, d.errorf("expected a value or { after %q", key)
+}
+
+func (d *TextDecoder) nextSkippingConditions() (*token, error) {
+	for {
+		t, err := d.next()
+		if err != nil || t.kind != tokenCondition {
+			return t, err
+		}
+	}
+}
+
+// Skips a condition that follows a value or section
+func (d *TextDecoder) skipCondition() error {
+	t, err := d.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != tokenCondition {
+		d.peeked = t
+	}
+	return nil
+}
+
+func (d *TextDecoder) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("keyvalues: line %d: %s", d.line, fmt.Sprintf(format, a...))
+}
+
+func (d *TextDecoder) readRune() (rune, error) {
+	r, _, err := d.r.ReadRune()
+	if r == '\n' {
+		d.line++
+	}
+	return r, err
+}
+
+func (d *TextDecoder) unreadRune(r rune) {
+	d.r.UnreadRune()
+	if r == '\n' {
+		d.line--
+	}
+}
+
+func (d *TextDecoder) next() (*token, error) {
+	if d.peeked != nil {
+		t := d.peeked
+		d.peeked = nil
+		return t, nil
+	}
+	for {
+		r, err := d.readRune()
+		if err == io.EOF {
+			return &token{kind: tokenEOF}, nil
+		} else if err != nil {
+			return nil, err
+		}
+		switch {
+		case r == ' ' || r == '\t' || r == '\r' || r == '\n' || r == '\uFEFF':
+			continue
+		case r == '/':
+			next, err := d.readRune()
+			if err == nil && next == '/' {
+				if _, err = d.r.ReadString('\n'); err == nil {
+					d.line++
+				} else if err != io.EOF {
+					return nil, err
+				}
+				continue
+			}
+			if err == nil {
+				d.unreadRune(next)
+			}
+			return d.readUnquoted(r)
+		case r == '{':
+			return &token{kind: tokenOpen}, nil
+		case r == '}':
+			return &token{kind: tokenClose}, nil
+		case r == '"':
+			return d.readQuoted()
+		case r == '[':
+			s, err := d.r.ReadString(']')
+			if err != nil {
+				return nil, d.errorf("unterminated condition")
+			}
+			return &token{kind: tokenCondition, value: s[:len(s)-1]}, nil
+		default:
+			return d.readUnquoted(r)
+		}
+	}
+}
+
+func (d *TextDecoder) readQuoted() (*token, error) {
+	var b strings.Builder
+	for {
+		r, err := d.readRune()
+		if err != nil {
+			return nil, d.errorf("unterminated string")
+		}
+		switch r {
+		case '"':
+			return &token{kind: tokenString, value: b.String()}, nil
+		case '\\':
+			next, err := d.readRune()
+			if err != nil {
+				return nil, d.errorf("unterminated string")
+			}
+			switch next {
+			case 'n':
+				b.WriteRune('\n')
+			case 't':
+				b.WriteRune('\t')
+			case '\\', '"':
+				b.WriteRune(next)
+			default:
+				b.WriteRune('\\')
+				b.WriteRune(next)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
+
+func (d *TextDecoder) readUnquoted(first rune) (*token, error) {
+	var b strings.Builder
+	b.WriteRune(first)
+	for {
+		r, err := d.readRune()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if strings.ContainsRune(" \t\r\n{}\"[", r) {
+			d.unreadRune(r)
+			break
+		}
+		b.WriteRune(r)
+	}
+	return &token{kind: tokenString, value: b.String()}, nil
+}
+
+// Reads the first node of a text document
+func ReadText(r io.Reader) (*KeyValue, error) {
+	kv, err := NewTextDecoder(r).Decode()
+	if err == io.EOF {
+		return nil, errors.New("keyvalues: empty document")
+	}
+	return kv, err
+}
+
+// Writes the node as text, indented with tabs
+func (kv *KeyValue) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	writeTextNode(bw, kv, 0)
+	return bw.Flush()
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`)
+
+func writeTextNode(w *bufio.Writer, kv *KeyValue, depth int) {
+	indent := strings.Repeat("\t", depth)
+	w.WriteString(indent + `"` + textEscaper.Replace(kv.Key) + `"`)
+	if !kv.IsSection() {
+		w.WriteString("\t\t\"" + textEscaper.Replace(kv.String()) + "\"\n")
+		return
+	}
+	w.WriteString("\n" + indent + "{\n")
+	for _, child := range kv.Children {
+		writeTextNode(w, child, depth+1)
+	}
+	w.WriteString(indent + "}\n")
+}
@@ -0,0 +1,191 @@
+package keyvalues
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Implemented by types that unmarshal themselves from a node
+type Unmarshaler interface {
+	UnmarshalKeyValue(kv *KeyValue) error
+}
+
+var (
+	unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	keyValueType    = reflect.TypeOf((*KeyValue)(nil))
+)
+
+// Stores the node in the value pointed to by v.
+//
+// Struct fields are matched to children by the key in their "kv" tag or
+// their name, case insensitively. Fields tagged with "-" are skipped and
+// children without a field are ignored:
+//
+//	type AppInfo struct {
+//		Name   string `kv:"name"`
+//		Type   string `kv:"type"`
+//		OSList string `kv:"oslist"`
+//		Depots map[string]*keyvalues.KeyValue `kv:"depots"`
+//	}
+//
+// Maps with string keys get an entry for every child and slices get every
+// child in order, like the "0", "1", ... sections Steam uses for lists.
+// Numbers and booleans are parsed from strings, a *KeyValue field gets the
+// node itself and an interface{} gets the value, or the node if it's a section.
+func Unmarshal(kv *KeyValue, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("keyvalues: Unmarshal needs a non-nil pointer")
+	}
+	return unmarshal(kv, rv.Elem(), kv.Key)
+}
+
+func unmarshal(kv *KeyValue, v reflect.Value, path string) error {
+	if v.Type() == keyValueType {
+		v.Set(reflect.ValueOf(kv))
+		return nil
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		return v.Addr().Interface().(Unmarshaler).UnmarshalKeyValue(kv)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshal(kv, v.Elem(), path)
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			break
+		}
+		if kv.IsSection() {
+			v.Set(reflect.ValueOf(kv))
+		} else if kv.Value != nil {
+			v.Set(reflect.ValueOf(kv.Value))
+		}
+		return nil
+	case reflect.Struct:
+		if !kv.IsSection() {
+			return unmarshalError(path, "expected a section for %v", v.Type())
+		}
+		return unmarshalStruct(kv, v, path)
+	case reflect.Map:
+		if !kv.IsSection() {
+			return unmarshalError(path, "expected a section for %v", v.Type())
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMapWithSize(v.Type(), len(kv.Children)))
+		}
+		for _, child := range kv.Children {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := unmarshal(child, elem, path+"."+child.Key); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(child.Key).Convert(v.Type().Key()), elem)
+		}
+		return nil
+	case reflect.Slice:
+		if !kv.IsSection() {
+			return unmarshalError(path, "expected a section for %v", v.Type())
+		}
+		slice := reflect.MakeSlice(v.Type(), len(kv.Children), len(kv.Children))
+		for i, child := range kv.Children {
+			if err := unmarshal(child, slice.Index(i), path+"."+child.Key); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	case reflect.String:
+		if kv.IsSection() {
+			return unmarshalError(path, "expected a value for %v", v.Type())
+		}
+		v.SetString(kv.String())
+		return nil
+	case reflect.Bool:
+		if kv.IsSection() {
+			return unmarshalError(path, "expected a value for %v", v.Type())
+		}
+		b, err := strconv.ParseBool(kv.String())
+		if err != nil {
+			n, nerr := kv.Int64()
+			if nerr != nil {
+				return unmarshalError(path, "%v", err)
+			}
+			b = n != 0
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := kv.Int64()
+		if err != nil {
+			return unmarshalError(path, "%v", err)
+		}
+		if v.OverflowInt(n) {
+			return unmarshalError(path, "%d overflows %v", n, v.Type())
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := kv.Uint64()
+		if err != nil {
+			return unmarshalError(path, "%v", err)
+		}
+		if v.OverflowUint(n) {
+			return unmarshalError(path, "%d overflows %v", n, v.Type())
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := kv.Float64()
+		if err != nil {
+			return unmarshalError(path, "%v", err)
+		}
+		v.SetFloat(f)
+		return nil
+	}
+	return unmarshalError(path, "can't unmarshal into %v", v.Type())
+}
+
+func unmarshalStruct(kv *KeyValue, v reflect.Value, path string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("kv")
+		if tag == "-" {
+			continue
+		}
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			if err := unmarshalStruct(kv, v.Field(i), path); err != nil {
+				return err
+			}
+			continue
+		}
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		key := field.Name
+		if tag != "" {
+			key = tag
+		}
+		child := kv.Child(key)
+		if child == nil {
+			continue
+		}
+		if err := unmarshal(child, v.Field(i), path+"."+child.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unmarshalError(path string, format string, a ...interface{}) error {
+	return fmt.Errorf("keyvalues: %s: %s", strings.TrimPrefix(path, "."), fmt.Sprintf(format, a...))
+}
@@ -0,0 +1,118 @@
+/*
+Implements methods to interact with the Steam Community Market.
+
+The client needs the cookies of a logged in community session, see community.Client.
+*/
+package market
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/vuquang23/go-steam/community"
+	"github.com/vuquang23/go-steam/netutil"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+)
+
+type Client struct {
+	client    *http.Client
+	sessionID string
+}
+
+func NewClient(sessionID string) *Client {
+	return &Client{
+		client:    new(http.Client),
+		sessionID: sessionID,
+	}
+}
+
+func (c *Client) SetProxy(proxy string) error {
+	proxyUrl, err := url.Parse(proxy)
+	if err != nil {
+		return err
+	}
+	c.client.Transport = &http.Transport{Proxy: http.ProxyURL(proxyUrl)}
+	return nil
+}
+
+func (c *Client) SetCookies(cookies []*http.Cookie) error {
+	return community.SetCookies(c.client, cookies)
+}
+
+func (c *Client) get(rawUrl string, params map[string]string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, rawUrl+"?"+netutil.ToUrlValues(params).Encode(), nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, v)
+}
+
+func (c *Client) post(rawUrl string, referer string, params map[string]string, v interface{}) error {
+	params["sessionid"] = c.sessionID
+	req := netutil.NewPostForm(rawUrl, netutil.ToUrlValues(params))
+	req.Header.Set("Referer", referer)
+	return c.do(req, v)
+}
+
+// status is the part all market responses have in common, when they succeed
+// or fail. Success is either a bool or an EResult.
+type status struct {
+	Success json.RawMessage `json:"success"`
+	Message string          `json:"message"`
+}
+
+func (s *status) result() steamlang.EResult {
+	if len(s.Success) == 0 {
+		// no success field, like the empty array of removelisting
+		return steamlang.EResult_OK
+	}
+	var ok bool
+	if err := json.Unmarshal(s.Success, &ok); err == nil {
+		if ok {
+			return steamlang.EResult_OK
+		}
+		return steamlang.EResult_Fail
+	}
+	code, err := strconv.ParseInt(string(s.Success), 10, 32)
+	if err != nil {
+		return steamlang.EResult_Invalid
+	}
+	return steamlang.EResult(code)
+}
+
+func (c *Client) do(req *http.Request, v interface{}) error {
+	req.Header.Set("User-Agent", defaultUserAgent)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var s status
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")) {
+		// errors are reported in the same object as the result
+		_ = json.Unmarshal(body, &s)
+	}
+	if resp.StatusCode != http.StatusOK {
+		e := &MarketError{StatusCode: resp.StatusCode, Message: s.Message}
+		if len(s.Success) != 0 {
+			e.Result = s.result()
+		}
+		return e
+	}
+	if result := s.result(); result != steamlang.EResult_OK {
+		return &MarketError{StatusCode: resp.StatusCode, Result: result, Message: s.Message}
+	}
+	if v == nil {
+		return nil
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,222 @@
+package market
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Returns a client whose requests are all answered with statusCode and body.
+func newTestClient(statusCode int, body string) *Client {
+	c := NewClient("session")
+	c.client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})
+	return c
+}
+
+// In the format of /market/mylistings/render/?norender=1, shortened.
+const myListingsResponse = `{
+	"success": true,
+	"pagesize": 100,
+	"total_count": 1,
+	"assets": [],
+	"start": 0,
+	"num_active_listings": 1,
+	"listings": [{
+		"listingid": "4374398284739204213",
+		"time_created": 1696152334,
+		"asset": {
+			"currency": 0,
+			"appid": 730,
+			"contextid": "2",
+			"id": "33575127853",
+			"classid": "310776767",
+			"instanceid": "302028390",
+			"amount": "1",
+			"status": 2,
+			"name": "Sealed Graffiti | Lambda (Monarch Blue)",
+			"market_hash_name": "Sealed Graffiti | Lambda (Monarch Blue)"
+		},
+		"steamid_lister": "76561198012345678",
+		"price": 3,
+		"fee": 2,
+		"currencyid": "2001",
+		"steam_fee": 1,
+		"publisher_fee": 1,
+		"converted_price": 3,
+		"converted_fee": 2,
+		"status": 2
+	}],
+	"listings_on_hold": [],
+	"listings_to_confirm": [{
+		"listingid": "4374398284739204214",
+		"time_created": 1696152390,
+		"asset": {"appid": 730, "contextid": "2", "id": "33575127854", "classid": "1", "instanceid": "0", "amount": "1"},
+		"steamid_lister": "76561198012345678",
+		"price": 100,
+		"fee": 14,
+		"status": 17
+	}],
+	"buy_orders": []
+}`
+
+func TestGetMyListings(t *testing.T) {
+	res, err := newTestClient(http.StatusOK, myListingsResponse).GetMyListings(0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalCount != 1 || res.NumActiveListings != 1 || len(res.Listings) != 1 || len(res.ListingsToConfirm) != 1 {
+		t.Fatalf("Unexpected result %+v", res)
+	}
+	listing := res.Listings[0]
+	if listing.ListingID != 4374398284739204213 || listing.Lister != 76561198012345678 ||
+		listing.Price != 3 || listing.Fee != 2 || listing.Status != ListingStatusActive {
+		t.Errorf("Unexpected listing %+v", listing)
+	}
+	if asset := listing.Asset; asset.AppID != 730 || asset.ContextID != 2 || asset.AssetID != 33575127853 ||
+		asset.ClassID != 310776767 || asset.InstanceID != 302028390 || asset.Amount != 1 {
+		t.Errorf("Unexpected asset %+v", asset)
+	}
+	if status := res.ListingsToConfirm[0].Status; status != ListingStatusNeedsConfirmation {
+		t.Errorf("Expected status %d, got %d", ListingStatusNeedsConfirmation, status)
+	}
+}
+
+// In the format of /market/myhistory/render/?norender=1, shortened.
+const myHistoryResponse = `{
+	"success": true,
+	"pagesize": 10,
+	"total_count": 2,
+	"start": 0,
+	"assets": {},
+	"events": [
+		{"listingid": "4374398284739204213", "purchaseid": "0", "event_type": 1, "time_event": 1696152334, "time_event_fraction": 0, "steamid_actor": "76561198012345678", "date_event": "Oct 1"},
+		{"listingid": "4374398284739204213", "purchaseid": "4374398284739209999", "event_type": 3, "time_event": 1696155000, "time_event_fraction": 0, "steamid_actor": "76561198087654321", "date_event": "Oct 1"}
+	],
+	"purchases": {},
+	"listings": {
+		"4374398284739204213": {
+			"listingid": "4374398284739204213",
+			"price": 3,
+			"fee": 2,
+			"original_price": 3,
+			"asset": {"currency": 0, "appid": 730, "contextid": "2", "id": "33575127853", "amount": "0"}
+		}
+	}
+}`
+
+func TestGetMyHistory(t *testing.T) {
+	res, err := newTestClient(http.StatusOK, myHistoryResponse).GetMyHistory(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalCount != 2 || len(res.Events) != 2 {
+		t.Fatalf("Unexpected result %+v", res)
+	}
+	sold := res.Events[1]
+	if sold.Type != HistoryEventListingSold || sold.PurchaseID != 4374398284739209999 || sold.Actor != 76561198087654321 {
+		t.Errorf("Unexpected event %+v", sold)
+	}
+	listing, err := res.Listings.Get(sold.ListingID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listing.Price != 3 || listing.Asset.AssetID != 33575127853 {
+		t.Errorf("Unexpected listing %+v", listing)
+	}
+}
+
+func TestGetMyHistoryEmpty(t *testing.T) {
+	res, err := newTestClient(http.StatusOK, `{"success":true,"pagesize":10,"total_count":0,"start":0,"events":[],"listings":[]}`).GetMyHistory(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Events) != 0 || len(res.Listings) != 0 {
+		t.Errorf("Expected an empty history, got %+v", res)
+	}
+}
+
+func TestGetPriceOverview(t *testing.T) {
+	c := newTestClient(http.StatusOK, `{"success":true,"lowest_price":"1,23€","volume":"1,234","median_price":"1,20€"}`)
+	res, err := c.GetPriceOverview(730, "Sealed Graffiti | Lambda (Monarch Blue)", steamlang.ECurrencyCode_EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := PriceOverview{LowestPrice: "1,23€", MedianPrice: "1,20€", Volume: "1,234"}
+	if *res != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *res)
+	}
+}
+
+func TestGetPriceHistory(t *testing.T) {
+	c := newTestClient(http.StatusOK, `{"success":true,"price_prefix":"$","price_suffix":"","prices":[["Jul 02 2014 01: +0",0.183,"1234"],["Oct 01 2023 13: +0",0.03,"56"]]}`)
+	res, err := c.GetPriceHistory(730, "Sealed Graffiti | Lambda (Monarch Blue)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PricePrefix != "$" || len(res.Prices) != 2 {
+		t.Fatalf("Unexpected result %+v", res)
+	}
+	point := res.Prices[0]
+	if !point.Time.Equal(time.Date(2014, time.July, 2, 1, 0, 0, 0, time.UTC)) || point.Price != 0.183 || point.Volume != 1234 {
+		t.Errorf("Unexpected price point %+v", point)
+	}
+}
+
+func TestMarketError(t *testing.T) {
+	for _, test := range []struct {
+		statusCode int
+		body       string
+		result     steamlang.EResult
+		target     error
+	}{
+		{http.StatusOK, `{"success":false}`, steamlang.EResult_Fail, nil},
+		{http.StatusOK, `{"success":21}`, steamlang.EResult_NotLoggedOn, ErrNotLoggedIn},
+		{http.StatusOK, `{"success":84,"message":"Too many requests"}`, steamlang.EResult_RateLimitExceeded, ErrTooManyRequests},
+		{http.StatusOK, `{"success":9}`, steamlang.EResult_FileNotFound, ErrListingNotFound},
+		{http.StatusBadGateway, `{"success":false,"message":"You must be logged in."}`, steamlang.EResult_Fail, nil},
+		{http.StatusUnauthorized, `null`, steamlang.EResult_Invalid, ErrNotLoggedIn},
+		{http.StatusForbidden, `<!DOCTYPE html><html><head><title>Sign In</title></head></html>`, steamlang.EResult_Invalid, ErrNotLoggedIn},
+		{http.StatusTooManyRequests, `<!DOCTYPE html><html><body>Too Many Requests</body></html>`, steamlang.EResult_Invalid, ErrTooManyRequests},
+		{http.StatusNotFound, `[]`, steamlang.EResult_Invalid, ErrListingNotFound},
+	} {
+		_, err := newTestClient(test.statusCode, test.body).GetMyListings(0, 10)
+		var marketErr *MarketError
+		if !errors.As(err, &marketErr) {
+			t.Errorf("Expected a MarketError for %d %s, got %v", test.statusCode, test.body, err)
+			continue
+		}
+		if marketErr.StatusCode != test.statusCode || marketErr.Result != test.result {
+			t.Errorf("Expected status %d and result %v for %s, got %d and %v", test.statusCode, test.result, test.body, marketErr.StatusCode, marketErr.Result)
+		}
+		for _, target := range []error{ErrNotLoggedIn, ErrTooManyRequests, ErrListingNotFound} {
+			if errors.Is(err, target) != (target == test.target) {
+				t.Errorf("Expected errors.Is(%v) to be %v for %d %s", target, target == test.target, test.statusCode, test.body)
+			}
+		}
+	}
+}
+
+func TestCancelListingWithoutSuccess(t *testing.T) {
+	// removelisting answers with an empty array
+	if err := newTestClient(http.StatusOK, `[]`).CancelListing(4374398284739204213); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
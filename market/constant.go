@@ -0,0 +1,26 @@
+package market
+
+const (
+	baseUrl = "https://steamcommunity.com/market"
+
+	priceOverviewUrl = baseUrl + "/priceoverview/"
+	priceHistoryUrl  = baseUrl + "/pricehistory/"
+	searchUrl        = baseUrl + "/search/render/"
+	sellItemUrl      = baseUrl + "/sellitem/"
+	removeListingUrl = baseUrl + "/removelisting/"
+	buyListingUrl    = baseUrl + "/buylisting/"
+	myListingsUrl    = baseUrl + "/mylistings/render/"
+	myHistoryUrl     = baseUrl + "/myhistory/render/"
+)
+
+const defaultUserAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/51.0.2704.103 Safari/537.36"
+
+// Fees Steam takes from every sale unless the game sets its own publisher fee.
+const (
+	SteamFeePercent            = 0.05
+	DefaultPublisherFeePercent = 0.10
+)
+
+// Layout of the dates in price history, like "Jul 02 2014 01: +0".
+// The offset is always zero and stripped before parsing.
+const priceHistoryDateLayout = "Jan 02 2006 15:"
@@ -0,0 +1,52 @@
+package market
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+)
+
+var (
+	ErrNotLoggedIn         = errors.New("not logged in to steam community")
+	ErrTooManyRequests     = errors.New("too many market requests")
+	ErrListingNotFound     = errors.New("market listing not found")
+	ErrCannotFindListing   = errors.New("unable to find listing to confirm")
+	ErrInvalidPriceHistory = errors.New("invalid price history")
+)
+
+// MarketError is returned when Steam refused a market request. Use errors.Is
+// with ErrNotLoggedIn, ErrTooManyRequests and ErrListingNotFound to tell common
+// causes apart.
+type MarketError struct {
+	StatusCode int
+	// EResult_Invalid if Steam didn't send a result code.
+	Result steamlang.EResult
+	// Steam's message, often localized.
+	Message string
+}
+
+func (e *MarketError) Error() string {
+	msg := fmt.Sprintf("market error: status code %d", e.StatusCode)
+	if e.Result != steamlang.EResult_Invalid {
+		msg += fmt.Sprintf(", result %v", e.Result)
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+func (e *MarketError) Is(target error) bool {
+	switch target {
+	case ErrNotLoggedIn:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden ||
+			e.Result == steamlang.EResult_NotLoggedOn
+	case ErrTooManyRequests:
+		return e.StatusCode == http.StatusTooManyRequests || e.Result == steamlang.EResult_RateLimitExceeded
+	case ErrListingNotFound:
+		return e.StatusCode == http.StatusNotFound || e.Result == steamlang.EResult_FileNotFound
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package market
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/vuquang23/go-steam/confirmation"
+	"github.com/vuquang23/go-steam/jsont"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+)
+
+type Fees struct {
+	SteamFee     uint64
+	PublisherFee uint64
+	// What the buyer pays, the amount the seller receives plus both fees.
+	Total uint64
+}
+
+// Calculates the fees on top of the amount the seller receives, the way the
+// market does. Pass DefaultPublisherFeePercent unless the game sets its own.
+func CalculateFees(received uint64, publisherFeePercent float64) Fees {
+	fees := Fees{
+		SteamFee: uint64(math.Floor(math.Max(float64(received)*SteamFeePercent, 1))),
+	}
+	if publisherFeePercent > 0 {
+		fees.PublisherFee = uint64(math.Floor(math.Max(float64(received)*publisherFeePercent, 1)))
+	}
+	fees.Total = received + fees.SteamFee + fees.PublisherFee
+	return fees
+}
+
+// Puts an item of our inventory up for sale. Unless Steam says otherwise in the
+// result, the listing has to be confirmed before it appears on the market.
+func (c *Client) Sell(item SellRequest) (*SellResult, error) {
+	amount := item.Amount
+	if amount == 0 {
+		amount = 1
+	}
+	var res struct {
+		RequiresConfirmation    jsont.UintBool `json:"requires_confirmation"`
+		NeedsMobileConfirmation bool           `json:"needs_mobile_confirmation"`
+		NeedsEmailConfirmation  bool           `json:"needs_email_confirmation"`
+		EmailDomain             string         `json:"email_domain"`
+	}
+	err := c.post(sellItemUrl, "https://steamcommunity.com/my/inventory/", map[string]string{
+		"appid":     strconv.FormatUint(uint64(item.AppID), 10),
+		"contextid": strconv.FormatUint(item.ContextID, 10),
+		"assetid":   strconv.FormatUint(item.AssetID, 10),
+		"amount":    strconv.FormatUint(amount, 10),
+		"price":     strconv.FormatUint(item.Price, 10),
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &SellResult{
+		RequiresConfirmation:    bool(res.RequiresConfirmation),
+		NeedsMobileConfirmation: res.NeedsMobileConfirmation,
+		NeedsEmailConfirmation:  res.NeedsEmailConfirmation,
+		EmailDomain:             res.EmailDomain,
+	}, nil
+}
+
+// Finds the mobile confirmation of a listing and accepts it.
+func (c *Client) ConfirmListing(confClient *confirmation.Client, listingID uint64) error {
+	confs, err := confClient.GetConfirmations()
+	if err != nil {
+		return err
+	}
+	creatorID := strconv.FormatUint(listingID, 10)
+	for _, conf := range confs {
+		// for market listings the creator is the listing itself
+		if conf.Type == confirmation.ConfirmationTypeMarketListing && conf.CreatorID == creatorID {
+			return confClient.AcceptConfirmation(conf)
+		}
+	}
+	return ErrCannotFindListing
+}
+
+// Sells an item and, if needed, accepts the mobile confirmation of the new
+// listing. Returns the listing id, or zero if Steam didn't ask for a
+// confirmation and the listing id is unknown.
+func (c *Client) SellAndConfirm(confClient *confirmation.Client, item SellRequest) (uint64, error) {
+	res, err := c.Sell(item)
+	if err != nil {
+		return 0, err
+	}
+	if !res.RequiresConfirmation || !res.NeedsMobileConfirmation {
+		return 0, nil
+	}
+
+	listings, err := c.GetMyListings(0, 100)
+	if err != nil {
+		return 0, err
+	}
+	for _, listing := range listings.ListingsToConfirm {
+		asset := listing.Asset
+		if asset != nil && asset.AppID == item.AppID && asset.ContextID == item.ContextID && asset.AssetID == item.AssetID {
+			return listing.ListingID, c.ConfirmListing(confClient, listing.ListingID)
+		}
+	}
+	return 0, ErrCannotFindListing
+}
+
+// Removes one of our listings and returns the item to our inventory.
+func (c *Client) CancelListing(listingID uint64) error {
+	return c.post(removeListingUrl+strconv.FormatUint(listingID, 10), baseUrl+"/", map[string]string{}, nil)
+}
+
+// Buys a listing of somebody else, paying from our wallet.
+func (c *Client) BuyListing(req BuyRequest) (*BuyResult, error) {
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	var res struct {
+		WalletInfo *struct {
+			WalletBalance  json.Number             `json:"wallet_balance"`
+			WalletCurrency steamlang.ECurrencyCode `json:"wallet_currency"`
+		} `json:"wallet_info"`
+	}
+	err := c.post(buyListingUrl+strconv.FormatUint(req.ListingID, 10), baseUrl+"/", map[string]string{
+		"currency":        strconv.FormatUint(uint64(req.Currency), 10),
+		"subtotal":        strconv.FormatUint(req.Subtotal, 10),
+		"fee":             strconv.FormatUint(req.Fee, 10),
+		"total":           strconv.FormatUint(req.Subtotal+req.Fee, 10),
+		"quantity":        strconv.FormatUint(quantity, 10),
+		"billing_state":   "",
+		"save_my_address": "0",
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+	result := new(BuyResult)
+	if res.WalletInfo != nil {
+		result.WalletBalance, _ = strconv.ParseUint(res.WalletInfo.WalletBalance.String(), 10, 64)
+		result.Currency = res.WalletInfo.WalletCurrency
+	}
+	return result, nil
+}
+
+// Gets a page of our active listings. Listings on hold and waiting for
+// confirmation are always returned in full.
+func (c *Client) GetMyListings(start uint, count uint) (*MyListingsResult, error) {
+	res := new(MyListingsResult)
+	err := c.get(myListingsUrl, map[string]string{
+		"start":    strconv.FormatUint(uint64(start), 10),
+		"count":    strconv.FormatUint(uint64(count), 10),
+		"norender": "1",
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Gets a page of our market history, newest first.
+func (c *Client) GetMyHistory(start uint, count uint) (*MyHistoryResult, error) {
+	res := new(MyHistoryResult)
+	err := c.get(myHistoryUrl, map[string]string{
+		"start":    strconv.FormatUint(uint64(start), 10),
+		"count":    strconv.FormatUint(uint64(count), 10),
+		"norender": "1",
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,120 @@
+package market
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+)
+
+// Gets the lowest and median price and the daily volume of an item.
+// Doesn't need a logged in session.
+func (c *Client) GetPriceOverview(appID uint32, marketHashName string, currency steamlang.ECurrencyCode) (*PriceOverview, error) {
+	res := new(PriceOverview)
+	err := c.get(priceOverviewUrl, map[string]string{
+		"appid":            strconv.FormatUint(uint64(appID), 10),
+		"market_hash_name": marketHashName,
+		"currency":         strconv.FormatUint(uint64(currency), 10),
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Gets the median sale price of every hour of the last month and of every day
+// before, oldest first. Prices are in our wallet currency.
+func (c *Client) GetPriceHistory(appID uint32, marketHashName string) (*PriceHistory, error) {
+	var res struct {
+		PricePrefix string              `json:"price_prefix"`
+		PriceSuffix string              `json:"price_suffix"`
+		Prices      [][]json.RawMessage `json:"prices"`
+	}
+	err := c.get(priceHistoryUrl, map[string]string{
+		"appid":            strconv.FormatUint(uint64(appID), 10),
+		"market_hash_name": marketHashName,
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &PriceHistory{
+		PricePrefix: res.PricePrefix,
+		PriceSuffix: res.PriceSuffix,
+		Prices:      make([]*PricePoint, 0, len(res.Prices)),
+	}
+	for _, p := range res.Prices {
+		point, err := parsePricePoint(p)
+		if err != nil {
+			return nil, err
+		}
+		history.Prices = append(history.Prices, point)
+	}
+	return history, nil
+}
+
+// Points look like ["Jul 02 2014 01: +0", 0.183, "1234"].
+func parsePricePoint(p []json.RawMessage) (*PricePoint, error) {
+	if len(p) != 3 {
+		return nil, ErrInvalidPriceHistory
+	}
+	var date, volume string
+	point := new(PricePoint)
+	if err := json.Unmarshal(p[0], &date); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(p[1], &point.Price); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(p[2], &volume); err != nil {
+		return nil, err
+	}
+
+	if i := strings.LastIndexByte(date, ' '); i >= 0 {
+		date = date[:i]
+	}
+	t, err := time.ParseInLocation(priceHistoryDateLayout, date, time.UTC)
+	if err != nil {
+		return nil, ErrInvalidPriceHistory
+	}
+	point.Time = t
+	point.Volume, err = strconv.ParseUint(volume, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidPriceHistory
+	}
+	return point, nil
+}
+
+// Searches the items that are for sale.
+func (c *Client) Search(options SearchOptions) (*SearchResult, error) {
+	count := options.Count
+	if count == 0 {
+		count = 10
+	}
+	params := map[string]string{
+		"query":    options.Query,
+		"start":    strconv.FormatUint(uint64(options.Start), 10),
+		"count":    strconv.FormatUint(uint64(count), 10),
+		"norender": "1",
+	}
+	if options.AppID != 0 {
+		params["appid"] = strconv.FormatUint(uint64(options.AppID), 10)
+	}
+	if options.SearchDescriptions {
+		params["search_descriptions"] = "1"
+	}
+	if options.SortColumn != "" {
+		params["sort_column"] = string(options.SortColumn)
+		params["sort_dir"] = "desc"
+		if options.SortAscending {
+			params["sort_dir"] = "asc"
+		}
+	}
+	res := new(SearchResult)
+	if err := c.get(searchUrl, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
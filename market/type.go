@@ -0,0 +1,216 @@
+package market
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/vuquang23/go-steam/jsont"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Prices are formatted in the requested currency, like "$1.23" or "1,23€".
+type PriceOverview struct {
+	LowestPrice string `json:"lowest_price"`
+	MedianPrice string `json:"median_price"`
+	// Number of items sold in the last 24 hours, like "1,234".
+	Volume string `json:"volume"`
+}
+
+type PricePoint struct {
+	Time time.Time
+	// Median price of the hour or day in the major unit of the wallet currency.
+	Price  float64
+	Volume uint64
+}
+
+type PriceHistory struct {
+	PricePrefix string
+	PriceSuffix string
+	Prices      []*PricePoint
+}
+
+type SortColumn string
+
+const (
+	SortColumnPopular  SortColumn = "popular"
+	SortColumnPrice    SortColumn = "price"
+	SortColumnQuantity SortColumn = "quantity"
+	SortColumnName     SortColumn = "name"
+)
+
+type SearchOptions struct {
+	Query string
+	// Zero searches all apps.
+	AppID uint32
+	// Also match the item descriptions, not only names.
+	SearchDescriptions bool
+	SortColumn         SortColumn
+	SortAscending      bool
+	Start              uint
+	// Steam returns at most 100 results per page. Defaults to 10.
+	Count uint
+}
+
+type SearchResult struct {
+	Start      uint            `json:"start"`
+	PageSize   uint            `json:"pagesize"`
+	TotalCount uint            `json:"total_count"`
+	Items      []*SearchedItem `json:"results"`
+}
+
+type SearchedItem struct {
+	Name     string `json:"name"`
+	HashName string `json:"hash_name"`
+	// Number of active sell listings.
+	SellListings uint64 `json:"sell_listings"`
+	// Lowest price in minor units of the wallet currency, including fees.
+	SellPrice        uint64            `json:"sell_price"`
+	SellPriceText    string            `json:"sell_price_text"`
+	AppName          string            `json:"app_name"`
+	AssetDescription *AssetDescription `json:"asset_description"`
+}
+
+// The part of an item's description the market sends along with listings and searches.
+type AssetDescription struct {
+	AppID           uint32         `json:"appid"`
+	ClassID         uint64         `json:"classid,string"`
+	InstanceID      uint64         `json:"instanceid,string"`
+	Name            string         `json:"name"`
+	MarketName      string         `json:"market_name"`
+	MarketHashName  string         `json:"market_hash_name"`
+	Type            string         `json:"type"`
+	IconUrl         string         `json:"icon_url"`
+	NameColor       string         `json:"name_color"`
+	BackgroundColor string         `json:"background_color"`
+	Tradable        jsont.UintBool `json:"tradable"`
+	Marketable      jsont.UintBool `json:"marketable"`
+	Commodity       jsont.UintBool `json:"commodity"`
+}
+
+// The item to put up for sale. Price is the amount we want to receive in
+// minor units of our wallet currency, fees are added on top for the buyer.
+type SellRequest struct {
+	AppID     uint32
+	ContextID uint64
+	AssetID   uint64
+	Amount    uint64
+	Price     uint64
+}
+
+type SellResult struct {
+	// The listing is only created once it is confirmed, see Client.ConfirmListing.
+	RequiresConfirmation    bool
+	NeedsMobileConfirmation bool
+	NeedsEmailConfirmation  bool
+	EmailDomain             string
+}
+
+type BuyRequest struct {
+	ListingID uint64
+	Currency  steamlang.ECurrencyCode
+	// Amount the seller receives, the listing's ConvertedPrice.
+	Subtotal uint64
+	// The listing's ConvertedFee.
+	Fee      uint64
+	Quantity uint64
+}
+
+type BuyResult struct {
+	// Our wallet balance after the purchase in minor units.
+	WalletBalance uint64
+	Currency      steamlang.ECurrencyCode
+}
+
+type ListingStatus uint
+
+const (
+	ListingStatusActive            ListingStatus = 2
+	ListingStatusNeedsConfirmation ListingStatus = 17
+	ListingStatusOnHold            ListingStatus = 23
+)
+
+type ListingAsset struct {
+	AppID          uint32 `json:"appid"`
+	ContextID      uint64 `json:"contextid,string"`
+	AssetID        uint64 `json:"id,string"`
+	ClassID        uint64 `json:"classid,string"`
+	InstanceID     uint64 `json:"instanceid,string"`
+	Amount         uint64 `json:"amount,string"`
+	Name           string `json:"name"`
+	MarketHashName string `json:"market_hash_name"`
+}
+
+// Prices are in minor units. Price and Fee are in the lister's currency,
+// the converted values in ours.
+type Listing struct {
+	ListingID      uint64          `json:"listingid,string"`
+	TimeCreated    uint32          `json:"time_created"`
+	Asset          *ListingAsset   `json:"asset"`
+	Lister         steamid.SteamId `json:"steamid_lister,string"`
+	Price          uint64          `json:"price"`
+	Fee            uint64          `json:"fee"`
+	SteamFee       uint64          `json:"steam_fee"`
+	PublisherFee   uint64          `json:"publisher_fee"`
+	ConvertedPrice uint64          `json:"converted_price"`
+	ConvertedFee   uint64          `json:"converted_fee"`
+	Status         ListingStatus   `json:"status"`
+}
+
+type MyListingsResult struct {
+	Start             uint       `json:"start"`
+	PageSize          uint       `json:"pagesize"`
+	TotalCount        uint       `json:"total_count"`
+	NumActiveListings uint       `json:"num_active_listings"`
+	Listings          []*Listing `json:"listings"`
+	ListingsOnHold    []*Listing `json:"listings_on_hold"`
+	// Listings waiting for a mobile or email confirmation.
+	ListingsToConfirm []*Listing `json:"listings_to_confirm"`
+}
+
+type HistoryEventType uint
+
+const (
+	HistoryEventListingCreated   HistoryEventType = 1
+	HistoryEventListingCancelled HistoryEventType = 2
+	HistoryEventListingSold      HistoryEventType = 3
+	HistoryEventListingPurchased HistoryEventType = 4
+)
+
+type HistoryEvent struct {
+	ListingID  uint64           `json:"listingid,string"`
+	PurchaseID uint64           `json:"purchaseid,string"`
+	Type       HistoryEventType `json:"event_type"`
+	TimeEvent  uint32           `json:"time_event"`
+	Actor      steamid.SteamId  `json:"steamid_actor,string"`
+}
+
+type MyHistoryResult struct {
+	Start      uint            `json:"start"`
+	PageSize   uint            `json:"pagesize"`
+	TotalCount uint            `json:"total_count"`
+	Events     []*HistoryEvent `json:"events"`
+	// The listings the events refer to.
+	Listings HistoryListings `json:"listings"`
+}
+
+// HistoryListings key is the listing id.
+type HistoryListings map[string]*Listing
+
+func (l *HistoryListings) Get(listingID uint64) (*Listing, error) {
+	if listing, ok := (*l)[strconv.FormatUint(listingID, 10)]; ok {
+		return listing, nil
+	}
+	return nil, fmt.Errorf("listing %d not found", listingID)
+}
+
+func (l *HistoryListings) UnmarshalJSON(data []byte) error {
+	// steam sends an empty array instead of an empty object
+	if bytes.Equal(data, []byte("[]")) {
+		return nil
+	}
+	return json.Unmarshal(data, (*map[string]*Listing)(l))
+}
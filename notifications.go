@@ -1,9 +1,12 @@
 package steam
 
 import (
+	"time"
+
 	"github.com/vuquang23/go-steam/protocol"
 	"github.com/vuquang23/go-steam/protocol/protobuf"
 	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/steamid"
 )
 
 type Notifications struct {
@@ -24,13 +27,55 @@ func (n *Notifications) HandlePacket(packet *protocol.Packet) {
 	switch packet.EMsg {
 	case steamlang.EMsg_ClientUserNotifications:
 		n.handleClientUserNotifications(packet)
+	case steamlang.EMsg_ClientCommentNotifications:
+		n.handleCommentNotifications(packet)
+	case steamlang.EMsg_ClientItemAnnouncements:
+		n.handleItemAnnouncements(packet)
+	case steamlang.EMsg_ClientChatOfflineMessageNotification:
+		n.handleOfflineMessageNotification(packet)
+	}
+}
+
+// Requests the number of new comments, a CommentNotificationsEvent is fired in response
+func (n *Notifications) RequestCommentNotifications() {
+	n.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientRequestCommentNotifications, &protobuf.CMsgClientRequestCommentNotifications{}))
+}
+
+// Requests the new items in our inventories, an ItemAnnouncementsEvent is fired in response
+func (n *Notifications) RequestItemAnnouncements() {
+	n.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientRequestItemAnnouncements, &protobuf.CMsgClientRequestItemAnnouncements{}))
+}
+
+// Polls trade offers on demand, like tradeoffer.Manager
+type TradeOfferPoller interface {
+	PollNow()
+}
+
+// Calls PollNow if the event reports pending trade offers. Pass it every
+// event of the client to poll offers as soon as Steam notifies about them:
+//
+//	for event := range client.Events() {
+//		steam.PollTradeOffersOnNotification(event, manager)
+//	}
+func PollTradeOffersOnNotification(event interface{}, poller TradeOfferPoller) {
+	if e, ok := event.(*NotificationEvent); ok && e.Type == TradeOffer && e.Count > 0 {
+		poller.PollNow()
 	}
 }
 
 type NotificationType uint
 
 const (
-	TradeOffer NotificationType = 1
+	TradeOffer       NotificationType = 1
+	GameTurn         NotificationType = 2 // turns in asynchronous games
+	ModeratorMessage NotificationType = 3
+	Comment          NotificationType = 4
+	Item             NotificationType = 5
+	Invite           NotificationType = 6 // friend and group invites
+	Gift             NotificationType = 8
+	OfflineMessage   NotificationType = 9
+	HelpRequestReply NotificationType = 10
+	AccountAlert     NotificationType = 11
 )
 
 func (n *Notifications) handleClientUserNotifications(packet *protocol.Packet) {
@@ -42,6 +87,9 @@ func (n *Notifications) handleClientUserNotifications(packet *protocol.Packet) {
 		count := uint(*notification.Count)
 		n.notifications[typ] = count
 		n.client.Emit(&NotificationEvent{typ, count})
+		if typ == ModeratorMessage {
+			n.client.Emit(&ModeratorMessageEvent{count})
+		}
 	}
 
 	// check if there is a notification in our map that isn't in the current packet
@@ -60,3 +108,46 @@ func (n *Notifications) handleClientUserNotifications(packet *protocol.Packet) {
 		}
 	}
 }
+
+func (n *Notifications) handleCommentNotifications(packet *protocol.Packet) {
+	msg := new(protobuf.CMsgClientCommentNotifications)
+	packet.ReadProtoMsg(msg)
+	n.client.Emit(&CommentNotificationsEvent{
+		NewComments:              msg.GetCountNewComments(),
+		NewCommentsOwner:         msg.GetCountNewCommentsOwner(),
+		NewCommentsSubscriptions: msg.GetCountNewCommentsSubscriptions(),
+	})
+}
+
+func (n *Notifications) handleItemAnnouncements(packet *protocol.Packet) {
+	msg := new(protobuf.CMsgClientItemAnnouncements)
+	packet.ReadProtoMsg(msg)
+	items := make([]UnseenItem, 0, len(msg.GetUnseenItems()))
+	for _, item := range msg.GetUnseenItems() {
+		items = append(items, UnseenItem{
+			AppId:       item.GetAppid(),
+			ContextId:   item.GetContextId(),
+			AssetId:     item.GetAssetId(),
+			Amount:      item.GetAmount(),
+			Gained:      time.Unix(int64(item.GetRtime32Gained()), 0),
+			SourceAppId: item.GetSourceAppid(),
+		})
+	}
+	n.client.Emit(&ItemAnnouncementsEvent{
+		Count: msg.GetCountNewItems(),
+		Items: items,
+	})
+}
+
+func (n *Notifications) handleOfflineMessageNotification(packet *protocol.Packet) {
+	msg := new(protobuf.CMsgClientOfflineMessageNotification)
+	packet.ReadProtoMsg(msg)
+	friends := make([]steamid.SteamId, 0, len(msg.GetFriendsWithOfflineMessages()))
+	for _, accountId := range msg.GetFriendsWithOfflineMessages() {
+		friends = append(friends, n.client.accountSteamId(accountId, steamlang.EAccountType_Individual))
+	}
+	n.client.Emit(&OfflineMessagesEvent{
+		Count:   msg.GetOfflineMessages(),
+		Friends: friends,
+	})
+}
@@ -1,9 +1,48 @@
 package steam
 
-// This event is emitted for every CMsgClientUserNotifications message and likewise only used for
-// trade offers. Unlike the the above it is also emitted when the count of a type that was tracked
-// before by this Notifications instance reaches zero.
+import (
+	"time"
+
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// This event is emitted for every notification type of a CMsgClientUserNotifications message.
+// It is also emitted when the count of a type that was tracked before by this Notifications
+// instance reaches zero.
 type NotificationEvent struct {
 	Type  NotificationType
 	Count uint
 }
+
+// Emitted along with the NotificationEvent of ModeratorMessage notifications
+type ModeratorMessageEvent struct {
+	Count uint
+}
+
+// Emitted in response to RequestCommentNotifications and when new comments were posted
+type CommentNotificationsEvent struct {
+	NewComments              uint32
+	NewCommentsOwner         uint32 // on our profile and our content
+	NewCommentsSubscriptions uint32 // in threads we subscribed to
+}
+
+// Emitted in response to RequestItemAnnouncements and when new items landed in our inventories
+type ItemAnnouncementsEvent struct {
+	Count uint32
+	Items []UnseenItem
+}
+
+type UnseenItem struct {
+	AppId       uint32
+	ContextId   uint64 `json:",string"`
+	AssetId     uint64 `json:",string"`
+	Amount      uint64
+	Gained      time.Time
+	SourceAppId uint32
+}
+
+// Emitted after logging on if friends sent us messages while we were offline
+type OfflineMessagesEvent struct {
+	Count   uint32
+	Friends []steamid.SteamId
+}
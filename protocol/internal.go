@@ -39,7 +39,7 @@ type MessageBody interface {
 // the default details to request in most situations
 const EClientPersonaStateFlag_DefaultInfoRequest = steamlang.EClientPersonaStateFlag_PlayerName |
 	steamlang.EClientPersonaStateFlag_Presence | steamlang.EClientPersonaStateFlag_SourceID |
-	steamlang.EClientPersonaStateFlag_GameExtraInfo
+	steamlang.EClientPersonaStateFlag_GameExtraInfo | steamlang.EClientPersonaStateFlag_LastSeen
 
 const DefaultAvatar = "fef49e7fa7e1997310d705b2a6158ff8dc1cdfeb"
 
@@ -0,0 +1,5425 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.1
+// source: steammessages_chat.steamclient.proto
+
+package unified
+
+import (
+	
+	
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EChatRoomJoinState int32
+
+const (
+	EChatRoomJoinState_k_EChatRoomJoinState_Default     EChatRoomJoinState = 0
+	EChatRoomJoinState_k_EChatRoomJoinState_None        EChatRoomJoinState = 1
+	EChatRoomJoinState_k_EChatRoomJoinState_Joined      EChatRoomJoinState = 2
+	EChatRoomJoinState_k_EChatRoomJoinState_TestInvalid EChatRoomJoinState = 99
+)
+
+// Enum value maps for EChatRoomJoinState.
+var (
+	EChatRoomJoinState_name = map[int32]string{
+		0:  "k_EChatRoomJoinState_Default",
+		1:  "k_EChatRoomJoinState_None",
+		2:  "k_EChatRoomJoinState_Joined",
+		99: "k_EChatRoomJoinState_TestInvalid",
+	}
+	EChatRoomJoinState_value = map[string]int32{
+		"k_EChatRoomJoinState_Default":     0,
+		"k_EChatRoomJoinState_None":        1,
+		"k_EChatRoomJoinState_Joined":      2,
+		"k_EChatRoomJoinState_TestInvalid": 99,
+	}
+)
+
+func (x EChatRoomJoinState) Enum() *EChatRoomJoinState {
+	p := new(EChatRoomJoinState)
+	*p = x
+	return p
+}
+
+func (x EChatRoomJoinState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EChatRoomJoinState) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_chat_steamclient_proto_enumTypes[0].Descriptor()
+}
+
+func (EChatRoomJoinState) Type() protoreflect.EnumType {
+	return &file_steammessages_chat_steamclient_proto_enumTypes[0]
+}
+
+func (x EChatRoomJoinState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EChatRoomJoinState) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EChatRoomJoinState(num)
+	return nil
+}
+
+// Deprecated: Use EChatRoomJoinState.Descriptor instead.
+func (EChatRoomJoinState) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+type EChatRoomGroupRank int32
+
+const (
+	EChatRoomGroupRank_k_EChatRoomGroupRank_Default     EChatRoomGroupRank = 0
+	EChatRoomGroupRank_k_EChatRoomGroupRank_Viewer      EChatRoomGroupRank = 10
+	EChatRoomGroupRank_k_EChatRoomGroupRank_Guest       EChatRoomGroupRank = 15
+	EChatRoomGroupRank_k_EChatRoomGroupRank_Member      EChatRoomGroupRank = 20
+	EChatRoomGroupRank_k_EChatRoomGroupRank_Moderator   EChatRoomGroupRank = 30
+	EChatRoomGroupRank_k_EChatRoomGroupRank_Officer     EChatRoomGroupRank = 40
+	EChatRoomGroupRank_k_EChatRoomGroupRank_Owner       EChatRoomGroupRank = 50
+	EChatRoomGroupRank_k_EChatRoomGroupRank_TestInvalid EChatRoomGroupRank = 99
+)
+
+// Enum value maps for EChatRoomGroupRank.
+var (
+	EChatRoomGroupRank_name = map[int32]string{
+		0:  "k_EChatRoomGroupRank_Default",
+		10: "k_EChatRoomGroupRank_Viewer",
+		15: "k_EChatRoomGroupRank_Guest",
+		20: "k_EChatRoomGroupRank_Member",
+		30: "k_EChatRoomGroupRank_Moderator",
+		40: "k_EChatRoomGroupRank_Officer",
+		50: "k_EChatRoomGroupRank_Owner",
+		99: "k_EChatRoomGroupRank_TestInvalid",
+	}
+	EChatRoomGroupRank_value = map[string]int32{
+		"k_EChatRoomGroupRank_Default":     0,
+		"k_EChatRoomGroupRank_Viewer":      10,
+		"k_EChatRoomGroupRank_Guest":       15,
+		"k_EChatRoomGroupRank_Member":      20,
+		"k_EChatRoomGroupRank_Moderator":   30,
+		"k_EChatRoomGroupRank_Officer":     40,
+		"k_EChatRoomGroupRank_Owner":       50,
+		"k_EChatRoomGroupRank_TestInvalid": 99,
+	}
+)
+
+func (x EChatRoomGroupRank) Enum() *EChatRoomGroupRank {
+	p := new(EChatRoomGroupRank)
+	*p = x
+	return p
+}
+
+func (x EChatRoomGroupRank) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EChatRoomGroupRank) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_chat_steamclient_proto_enumTypes[1].Descriptor()
+}
+
+func (EChatRoomGroupRank) Type() protoreflect.EnumType {
+	return &file_steammessages_chat_steamclient_proto_enumTypes[1]
+}
+
+func (x EChatRoomGroupRank) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EChatRoomGroupRank) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EChatRoomGroupRank(num)
+	return nil
+}
+
+// Deprecated: Use EChatRoomGroupRank.Descriptor instead.
+func (EChatRoomGroupRank) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+type EChatRoomNotificationLevel int32
+
+const (
+	EChatRoomNotificationLevel_k_EChatroomNotificationLevel_Invalid     EChatRoomNotificationLevel = 0
+	EChatRoomNotificationLevel_k_EChatroomNotificationLevel_None        EChatRoomNotificationLevel = 1
+	EChatRoomNotificationLevel_k_EChatroomNotificationLevel_MentionMe   EChatRoomNotificationLevel = 2
+	EChatRoomNotificationLevel_k_EChatroomNotificationLevel_MentionAll  EChatRoomNotificationLevel = 3
+	EChatRoomNotificationLevel_k_EChatroomNotificationLevel_AllMessages EChatRoomNotificationLevel = 4
+)
+
+// Enum value maps for EChatRoomNotificationLevel.
+var (
+	EChatRoomNotificationLevel_name = map[int32]string{
+		0: "k_EChatroomNotificationLevel_Invalid",
+		1: "k_EChatroomNotificationLevel_None",
+		2: "k_EChatroomNotificationLevel_MentionMe",
+		3: "k_EChatroomNotificationLevel_MentionAll",
+		4: "k_EChatroomNotificationLevel_AllMessages",
+	}
+	EChatRoomNotificationLevel_value = map[string]int32{
+		"k_EChatroomNotificationLevel_Invalid":     0,
+		"k_EChatroomNotificationLevel_None":        1,
+		"k_EChatroomNotificationLevel_MentionMe":   2,
+		"k_EChatroomNotificationLevel_MentionAll":  3,
+		"k_EChatroomNotificationLevel_AllMessages": 4,
+	}
+)
+
+func (x EChatRoomNotificationLevel) Enum() *EChatRoomNotificationLevel {
+	p := new(EChatRoomNotificationLevel)
+	*p = x
+	return p
+}
+
+func (x EChatRoomNotificationLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EChatRoomNotificationLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_chat_steamclient_proto_enumTypes[2].Descriptor()
+}
+
+func (EChatRoomNotificationLevel) Type() protoreflect.EnumType {
+	return &file_steammessages_chat_steamclient_proto_enumTypes[2]
+}
+
+func (x EChatRoomNotificationLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EChatRoomNotificationLevel) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EChatRoomNotificationLevel(num)
+	return nil
+}
+
+// Deprecated: Use EChatRoomNotificationLevel.Descriptor instead.
+func (EChatRoomNotificationLevel) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+type EChatRoomServerMessage int32
+
+const (
+	EChatRoomServerMessage_k_EChatRoomServerMsg_Invalid                EChatRoomServerMessage = 0
+	EChatRoomServerMessage_k_EChatRoomServerMsg_RenameChatRoom         EChatRoomServerMessage = 1
+	EChatRoomServerMessage_k_EChatRoomServerMsg_Joined                 EChatRoomServerMessage = 2
+	EChatRoomServerMessage_k_EChatRoomServerMsg_Parted                 EChatRoomServerMessage = 3
+	EChatRoomServerMessage_k_EChatRoomServerMsg_Kicked                 EChatRoomServerMessage = 4
+	EChatRoomServerMessage_k_EChatRoomServerMsg_Invited                EChatRoomServerMessage = 5
+	EChatRoomServerMessage_k_EChatRoomServerMsg_InviteDismissed        EChatRoomServerMessage = 8
+	EChatRoomServerMessage_k_EChatRoomServerMsg_ChatRoomTaglineChanged EChatRoomServerMessage = 9
+	EChatRoomServerMessage_k_EChatRoomServerMsg_ChatRoomAvatarChanged  EChatRoomServerMessage = 10
+	EChatRoomServerMessage_k_EChatRoomServerMsg_AppCustom              EChatRoomServerMessage = 11
+)
+
+// Enum value maps for EChatRoomServerMessage.
+var (
+	EChatRoomServerMessage_name = map[int32]string{
+		0:  "k_EChatRoomServerMsg_Invalid",
+		1:  "k_EChatRoomServerMsg_RenameChatRoom",
+		2:  "k_EChatRoomServerMsg_Joined",
+		3:  "k_EChatRoomServerMsg_Parted",
+		4:  "k_EChatRoomServerMsg_Kicked",
+		5:  "k_EChatRoomServerMsg_Invited",
+		8:  "k_EChatRoomServerMsg_InviteDismissed",
+		9:  "k_EChatRoomServerMsg_ChatRoomTaglineChanged",
+		10: "k_EChatRoomServerMsg_ChatRoomAvatarChanged",
+		11: "k_EChatRoomServerMsg_AppCustom",
+	}
+	EChatRoomServerMessage_value = map[string]int32{
+		"k_EChatRoomServerMsg_Invalid":                0,
+		"k_EChatRoomServerMsg_RenameChatRoom":         1,
+		"k_EChatRoomServerMsg_Joined":                 2,
+		"k_EChatRoomServerMsg_Parted":                 3,
+		"k_EChatRoomServerMsg_Kicked":                 4,
+		"k_EChatRoomServerMsg_Invited":                5,
+		"k_EChatRoomServerMsg_InviteDismissed":        8,
+		"k_EChatRoomServerMsg_ChatRoomTaglineChanged": 9,
+		"k_EChatRoomServerMsg_ChatRoomAvatarChanged":  10,
+		"k_EChatRoomServerMsg_AppCustom":              11,
+	}
+)
+
+func (x EChatRoomServerMessage) Enum() *EChatRoomServerMessage {
+	p := new(EChatRoomServerMessage)
+	*p = x
+	return p
+}
+
+func (x EChatRoomServerMessage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EChatRoomServerMessage) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_chat_steamclient_proto_enumTypes[3].Descriptor()
+}
+
+func (EChatRoomServerMessage) Type() protoreflect.EnumType {
+	return &file_steammessages_chat_steamclient_proto_enumTypes[3]
+}
+
+func (x EChatRoomServerMessage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EChatRoomServerMessage) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EChatRoomServerMessage(num)
+	return nil
+}
+
+// Deprecated: Use EChatRoomServerMessage.Descriptor instead.
+func (EChatRoomServerMessage) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+type EChatRoomMemberStateChange int32
+
+const (
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Invalid         EChatRoomMemberStateChange = 0
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Joined          EChatRoomMemberStateChange = 1
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Parted          EChatRoomMemberStateChange = 2
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Kicked          EChatRoomMemberStateChange = 3
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Invited         EChatRoomMemberStateChange = 4
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_RankChanged     EChatRoomMemberStateChange = 7
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_InviteDismissed EChatRoomMemberStateChange = 8
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Muted           EChatRoomMemberStateChange = 9
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Banned          EChatRoomMemberStateChange = 10
+	EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_RolesChanged    EChatRoomMemberStateChange = 12
+)
+
+// Enum value maps for EChatRoomMemberStateChange.
+var (
+	EChatRoomMemberStateChange_name = map[int32]string{
+		0:  "k_EChatRoomMemberStateChange_Invalid",
+		1:  "k_EChatRoomMemberStateChange_Joined",
+		2:  "k_EChatRoomMemberStateChange_Parted",
+		3:  "k_EChatRoomMemberStateChange_Kicked",
+		4:  "k_EChatRoomMemberStateChange_Invited",
+		7:  "k_EChatRoomMemberStateChange_RankChanged",
+		8:  "k_EChatRoomMemberStateChange_InviteDismissed",
+		9:  "k_EChatRoomMemberStateChange_Muted",
+		10: "k_EChatRoomMemberStateChange_Banned",
+		12: "k_EChatRoomMemberStateChange_RolesChanged",
+	}
+	EChatRoomMemberStateChange_value = map[string]int32{
+		"k_EChatRoomMemberStateChange_Invalid":         0,
+		"k_EChatRoomMemberStateChange_Joined":          1,
+		"k_EChatRoomMemberStateChange_Parted":          2,
+		"k_EChatRoomMemberStateChange_Kicked":          3,
+		"k_EChatRoomMemberStateChange_Invited":         4,
+		"k_EChatRoomMemberStateChange_RankChanged":     7,
+		"k_EChatRoomMemberStateChange_InviteDismissed": 8,
+		"k_EChatRoomMemberStateChange_Muted":           9,
+		"k_EChatRoomMemberStateChange_Banned":          10,
+		"k_EChatRoomMemberStateChange_RolesChanged":    12,
+	}
+)
+
+func (x EChatRoomMemberStateChange) Enum() *EChatRoomMemberStateChange {
+	p := new(EChatRoomMemberStateChange)
+	*p = x
+	return p
+}
+
+func (x EChatRoomMemberStateChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EChatRoomMemberStateChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_chat_steamclient_proto_enumTypes[4].Descriptor()
+}
+
+func (EChatRoomMemberStateChange) Type() protoreflect.EnumType {
+	return &file_steammessages_chat_steamclient_proto_enumTypes[4]
+}
+
+func (x EChatRoomMemberStateChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EChatRoomMemberStateChange) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EChatRoomMemberStateChange(num)
+	return nil
+}
+
+// Deprecated: Use EChatRoomMemberStateChange.Descriptor instead.
+func (EChatRoomMemberStateChange) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+type CChatRoleActions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId                       *uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	CanCreateRenameDeleteChannel *bool   `protobuf:"varint,2,opt,name=can_create_rename_delete_channel,json=canCreateRenameDeleteChannel" json:"can_create_rename_delete_channel,omitempty"`
+	CanKick                      *bool   `protobuf:"varint,3,opt,name=can_kick,json=canKick" json:"can_kick,omitempty"`
+	CanBan                       *bool   `protobuf:"varint,4,opt,name=can_ban,json=canBan" json:"can_ban,omitempty"`
+	CanInvite                    *bool   `protobuf:"varint,5,opt,name=can_invite,json=canInvite" json:"can_invite,omitempty"`
+	CanChangeTaglineAvatarName   *bool   `protobuf:"varint,6,opt,name=can_change_tagline_avatar_name,json=canChangeTaglineAvatarName" json:"can_change_tagline_avatar_name,omitempty"`
+	CanChat                      *bool   `protobuf:"varint,7,opt,name=can_chat,json=canChat" json:"can_chat,omitempty"`
+	CanViewHistory               *bool   `protobuf:"varint,8,opt,name=can_view_history,json=canViewHistory" json:"can_view_history,omitempty"`
+	CanChangeGroupRoles          *bool   `protobuf:"varint,9,opt,name=can_change_group_roles,json=canChangeGroupRoles" json:"can_change_group_roles,omitempty"`
+	CanChangeUserRoles           *bool   `protobuf:"varint,10,opt,name=can_change_user_roles,json=canChangeUserRoles" json:"can_change_user_roles,omitempty"`
+	CanMentionAll                *bool   `protobuf:"varint,11,opt,name=can_mention_all,json=canMentionAll" json:"can_mention_all,omitempty"`
+	CanSetWatchingBroadcast      *bool   `protobuf:"varint,12,opt,name=can_set_watching_broadcast,json=canSetWatchingBroadcast" json:"can_set_watching_broadcast,omitempty"`
+}
+
+func (x *CChatRoleActions) Reset() {
+	*x = CChatRoleActions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoleActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoleActions) ProtoMessage() {}
+
+func (x *CChatRoleActions) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoleActions.ProtoReflect.Descriptor instead.
+func (*CChatRoleActions) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CChatRoleActions) GetRoleId() uint64 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+func (x *CChatRoleActions) GetCanCreateRenameDeleteChannel() bool {
+	if x != nil && x.CanCreateRenameDeleteChannel != nil {
+		return *x.CanCreateRenameDeleteChannel
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanKick() bool {
+	if x != nil && x.CanKick != nil {
+		return *x.CanKick
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanBan() bool {
+	if x != nil && x.CanBan != nil {
+		return *x.CanBan
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanInvite() bool {
+	if x != nil && x.CanInvite != nil {
+		return *x.CanInvite
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanChangeTaglineAvatarName() bool {
+	if x != nil && x.CanChangeTaglineAvatarName != nil {
+		return *x.CanChangeTaglineAvatarName
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanChat() bool {
+	if x != nil && x.CanChat != nil {
+		return *x.CanChat
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanViewHistory() bool {
+	if x != nil && x.CanViewHistory != nil {
+		return *x.CanViewHistory
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanChangeGroupRoles() bool {
+	if x != nil && x.CanChangeGroupRoles != nil {
+		return *x.CanChangeGroupRoles
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanChangeUserRoles() bool {
+	if x != nil && x.CanChangeUserRoles != nil {
+		return *x.CanChangeUserRoles
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanMentionAll() bool {
+	if x != nil && x.CanMentionAll != nil {
+		return *x.CanMentionAll
+	}
+	return false
+}
+
+func (x *CChatRoleActions) GetCanSetWatchingBroadcast() bool {
+	if x != nil && x.CanSetWatchingBroadcast != nil {
+		return *x.CanSetWatchingBroadcast
+	}
+	return false
+}
+
+type CChatRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId  *uint64 `protobuf:"varint,1,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	Name    *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Ordinal *uint32 `protobuf:"varint,3,opt,name=ordinal" json:"ordinal,omitempty"`
+}
+
+func (x *CChatRole) Reset() {
+	*x = CChatRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRole) ProtoMessage() {}
+
+func (x *CChatRole) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRole.ProtoReflect.Descriptor instead.
+func (*CChatRole) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CChatRole) GetRoleId() uint64 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+func (x *CChatRole) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CChatRole) GetOrdinal() uint32 {
+	if x != nil && x.Ordinal != nil {
+		return *x.Ordinal
+	}
+	return 0
+}
+
+type CChatRoomState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId               *uint64  `protobuf:"varint,1,opt,name=chat_id,json=chatId" json:"chat_id,omitempty"`
+	ChatName             *string  `protobuf:"bytes,2,opt,name=chat_name,json=chatName" json:"chat_name,omitempty"`
+	VoiceAllowed         *bool    `protobuf:"varint,3,opt,name=voice_allowed,json=voiceAllowed" json:"voice_allowed,omitempty"`
+	MembersInVoice       []uint32 `protobuf:"varint,4,rep,name=members_in_voice,json=membersInVoice" json:"members_in_voice,omitempty"`
+	TimeLastMessage      *uint32  `protobuf:"varint,5,opt,name=time_last_message,json=timeLastMessage" json:"time_last_message,omitempty"`
+	SortOrder            *uint32  `protobuf:"varint,6,opt,name=sort_order,json=sortOrder" json:"sort_order,omitempty"`
+	LastMessage          *string  `protobuf:"bytes,7,opt,name=last_message,json=lastMessage" json:"last_message,omitempty"`
+	AccountidLastMessage *uint32  `protobuf:"varint,8,opt,name=accountid_last_message,json=accountidLastMessage" json:"accountid_last_message,omitempty"`
+}
+
+func (x *CChatRoomState) Reset() {
+	*x = CChatRoomState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomState) ProtoMessage() {}
+
+func (x *CChatRoomState) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomState.ProtoReflect.Descriptor instead.
+func (*CChatRoomState) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CChatRoomState) GetChatId() uint64 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *CChatRoomState) GetChatName() string {
+	if x != nil && x.ChatName != nil {
+		return *x.ChatName
+	}
+	return ""
+}
+
+func (x *CChatRoomState) GetVoiceAllowed() bool {
+	if x != nil && x.VoiceAllowed != nil {
+		return *x.VoiceAllowed
+	}
+	return false
+}
+
+func (x *CChatRoomState) GetMembersInVoice() []uint32 {
+	if x != nil {
+		return x.MembersInVoice
+	}
+	return nil
+}
+
+func (x *CChatRoomState) GetTimeLastMessage() uint32 {
+	if x != nil && x.TimeLastMessage != nil {
+		return *x.TimeLastMessage
+	}
+	return 0
+}
+
+func (x *CChatRoomState) GetSortOrder() uint32 {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return 0
+}
+
+func (x *CChatRoomState) GetLastMessage() string {
+	if x != nil && x.LastMessage != nil {
+		return *x.LastMessage
+	}
+	return ""
+}
+
+func (x *CChatRoomState) GetAccountidLastMessage() uint32 {
+	if x != nil && x.AccountidLastMessage != nil {
+		return *x.AccountidLastMessage
+	}
+	return 0
+}
+
+type CChatRoomGroupHeaderState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId                *uint64             `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	ChatName                   *string             `protobuf:"bytes,2,opt,name=chat_name,json=chatName" json:"chat_name,omitempty"`
+	Clanid                     *uint32             `protobuf:"varint,13,opt,name=clanid" json:"clanid,omitempty"`
+	AccountidOwner             *uint32             `protobuf:"varint,14,opt,name=accountid_owner,json=accountidOwner" json:"accountid_owner,omitempty"`
+	Appid                      *uint32             `protobuf:"varint,21,opt,name=appid" json:"appid,omitempty"`
+	Tagline                    *string             `protobuf:"bytes,15,opt,name=tagline" json:"tagline,omitempty"`
+	AvatarSha                  []byte              `protobuf:"bytes,16,opt,name=avatar_sha,json=avatarSha" json:"avatar_sha,omitempty"`
+	DefaultRoleId              *uint64             `protobuf:"varint,17,opt,name=default_role_id,json=defaultRoleId" json:"default_role_id,omitempty"`
+	Roles                      []*CChatRole        `protobuf:"bytes,18,rep,name=roles" json:"roles,omitempty"`
+	RoleActions                []*CChatRoleActions `protobuf:"bytes,19,rep,name=role_actions,json=roleActions" json:"role_actions,omitempty"`
+	WatchingBroadcastAccountid *uint32             `protobuf:"varint,20,opt,name=watching_broadcast_accountid,json=watchingBroadcastAccountid" json:"watching_broadcast_accountid,omitempty"`
+	WatchingBroadcastChannelId *uint64             `protobuf:"varint,23,opt,name=watching_broadcast_channel_id,json=watchingBroadcastChannelId" json:"watching_broadcast_channel_id,omitempty"`
+	ActiveMinigameId           *uint64             `protobuf:"varint,24,opt,name=active_minigame_id,json=activeMinigameId" json:"active_minigame_id,omitempty"`
+	AvatarUgcUrl               *string             `protobuf:"bytes,25,opt,name=avatar_ugc_url,json=avatarUgcUrl" json:"avatar_ugc_url,omitempty"`
+	Disabled                   *bool               `protobuf:"varint,26,opt,name=disabled" json:"disabled,omitempty"`
+}
+
+func (x *CChatRoomGroupHeaderState) Reset() {
+	*x = CChatRoomGroupHeaderState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomGroupHeaderState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomGroupHeaderState) ProtoMessage() {}
+
+func (x *CChatRoomGroupHeaderState) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomGroupHeaderState.ProtoReflect.Descriptor instead.
+func (*CChatRoomGroupHeaderState) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CChatRoomGroupHeaderState) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetChatName() string {
+	if x != nil && x.ChatName != nil {
+		return *x.ChatName
+	}
+	return ""
+}
+
+func (x *CChatRoomGroupHeaderState) GetClanid() uint32 {
+	if x != nil && x.Clanid != nil {
+		return *x.Clanid
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetAccountidOwner() uint32 {
+	if x != nil && x.AccountidOwner != nil {
+		return *x.AccountidOwner
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetTagline() string {
+	if x != nil && x.Tagline != nil {
+		return *x.Tagline
+	}
+	return ""
+}
+
+func (x *CChatRoomGroupHeaderState) GetAvatarSha() []byte {
+	if x != nil {
+		return x.AvatarSha
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupHeaderState) GetDefaultRoleId() uint64 {
+	if x != nil && x.DefaultRoleId != nil {
+		return *x.DefaultRoleId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetRoles() []*CChatRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupHeaderState) GetRoleActions() []*CChatRoleActions {
+	if x != nil {
+		return x.RoleActions
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupHeaderState) GetWatchingBroadcastAccountid() uint32 {
+	if x != nil && x.WatchingBroadcastAccountid != nil {
+		return *x.WatchingBroadcastAccountid
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetWatchingBroadcastChannelId() uint64 {
+	if x != nil && x.WatchingBroadcastChannelId != nil {
+		return *x.WatchingBroadcastChannelId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetActiveMinigameId() uint64 {
+	if x != nil && x.ActiveMinigameId != nil {
+		return *x.ActiveMinigameId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupHeaderState) GetAvatarUgcUrl() string {
+	if x != nil && x.AvatarUgcUrl != nil {
+		return *x.AvatarUgcUrl
+	}
+	return ""
+}
+
+func (x *CChatRoomGroupHeaderState) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+type CChatRoomMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accountid      *uint32             `protobuf:"varint,1,opt,name=accountid" json:"accountid,omitempty"`
+	State          *EChatRoomJoinState `protobuf:"varint,3,opt,name=state,enum=EChatRoomJoinState,def=0" json:"state,omitempty"`
+	Rank           *EChatRoomGroupRank `protobuf:"varint,4,opt,name=rank,enum=EChatRoomGroupRank,def=0" json:"rank,omitempty"`
+	TimeKickExpire *uint32             `protobuf:"varint,6,opt,name=time_kick_expire,json=timeKickExpire" json:"time_kick_expire,omitempty"`
+	RoleIds        []uint64            `protobuf:"varint,7,rep,name=role_ids,json=roleIds" json:"role_ids,omitempty"`
+}
+
+// Default values for CChatRoomMember fields.
+const (
+	Default_CChatRoomMember_State = EChatRoomJoinState_k_EChatRoomJoinState_Default
+	Default_CChatRoomMember_Rank  = EChatRoomGroupRank_k_EChatRoomGroupRank_Default
+)
+
+func (x *CChatRoomMember) Reset() {
+	*x = CChatRoomMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomMember) ProtoMessage() {}
+
+func (x *CChatRoomMember) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomMember.ProtoReflect.Descriptor instead.
+func (*CChatRoomMember) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CChatRoomMember) GetAccountid() uint32 {
+	if x != nil && x.Accountid != nil {
+		return *x.Accountid
+	}
+	return 0
+}
+
+func (x *CChatRoomMember) GetState() EChatRoomJoinState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return Default_CChatRoomMember_State
+}
+
+func (x *CChatRoomMember) GetRank() EChatRoomGroupRank {
+	if x != nil && x.Rank != nil {
+		return *x.Rank
+	}
+	return Default_CChatRoomMember_Rank
+}
+
+func (x *CChatRoomMember) GetTimeKickExpire() uint32 {
+	if x != nil && x.TimeKickExpire != nil {
+		return *x.TimeKickExpire
+	}
+	return 0
+}
+
+func (x *CChatRoomMember) GetRoleIds() []uint64 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+type CUserChatRoomState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId                   *uint64                     `protobuf:"varint,1,opt,name=chat_id,json=chatId" json:"chat_id,omitempty"`
+	TimeJoined               *uint32                     `protobuf:"varint,2,opt,name=time_joined,json=timeJoined" json:"time_joined,omitempty"`
+	TimeLastAck              *uint32                     `protobuf:"varint,3,opt,name=time_last_ack,json=timeLastAck" json:"time_last_ack,omitempty"`
+	DesktopNotificationLevel *EChatRoomNotificationLevel `protobuf:"varint,4,opt,name=desktop_notification_level,json=desktopNotificationLevel,enum=EChatRoomNotificationLevel,def=0" json:"desktop_notification_level,omitempty"`
+	MobileNotificationLevel  *EChatRoomNotificationLevel `protobuf:"varint,5,opt,name=mobile_notification_level,json=mobileNotificationLevel,enum=EChatRoomNotificationLevel,def=0" json:"mobile_notification_level,omitempty"`
+	TimeLastMention          *uint32                     `protobuf:"varint,6,opt,name=time_last_mention,json=timeLastMention" json:"time_last_mention,omitempty"`
+	UnreadIndicatorMuted     *bool                       `protobuf:"varint,7,opt,name=unread_indicator_muted,json=unreadIndicatorMuted,def=0" json:"unread_indicator_muted,omitempty"`
+	TimeFirstUnread          *uint32                     `protobuf:"varint,8,opt,name=time_first_unread,json=timeFirstUnread" json:"time_first_unread,omitempty"`
+}
+
+// Default values for CUserChatRoomState fields.
+const (
+	Default_CUserChatRoomState_DesktopNotificationLevel = EChatRoomNotificationLevel_k_EChatroomNotificationLevel_Invalid
+	Default_CUserChatRoomState_MobileNotificationLevel  = EChatRoomNotificationLevel_k_EChatroomNotificationLevel_Invalid
+	Default_CUserChatRoomState_UnreadIndicatorMuted     = bool(false)
+)
+
+func (x *CUserChatRoomState) Reset() {
+	*x = CUserChatRoomState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CUserChatRoomState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CUserChatRoomState) ProtoMessage() {}
+
+func (x *CUserChatRoomState) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CUserChatRoomState.ProtoReflect.Descriptor instead.
+func (*CUserChatRoomState) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CUserChatRoomState) GetChatId() uint64 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *CUserChatRoomState) GetTimeJoined() uint32 {
+	if x != nil && x.TimeJoined != nil {
+		return *x.TimeJoined
+	}
+	return 0
+}
+
+func (x *CUserChatRoomState) GetTimeLastAck() uint32 {
+	if x != nil && x.TimeLastAck != nil {
+		return *x.TimeLastAck
+	}
+	return 0
+}
+
+func (x *CUserChatRoomState) GetDesktopNotificationLevel() EChatRoomNotificationLevel {
+	if x != nil && x.DesktopNotificationLevel != nil {
+		return *x.DesktopNotificationLevel
+	}
+	return Default_CUserChatRoomState_DesktopNotificationLevel
+}
+
+func (x *CUserChatRoomState) GetMobileNotificationLevel() EChatRoomNotificationLevel {
+	if x != nil && x.MobileNotificationLevel != nil {
+		return *x.MobileNotificationLevel
+	}
+	return Default_CUserChatRoomState_MobileNotificationLevel
+}
+
+func (x *CUserChatRoomState) GetTimeLastMention() uint32 {
+	if x != nil && x.TimeLastMention != nil {
+		return *x.TimeLastMention
+	}
+	return 0
+}
+
+func (x *CUserChatRoomState) GetUnreadIndicatorMuted() bool {
+	if x != nil && x.UnreadIndicatorMuted != nil {
+		return *x.UnreadIndicatorMuted
+	}
+	return Default_CUserChatRoomState_UnreadIndicatorMuted
+}
+
+func (x *CUserChatRoomState) GetTimeFirstUnread() uint32 {
+	if x != nil && x.TimeFirstUnread != nil {
+		return *x.TimeFirstUnread
+	}
+	return 0
+}
+
+type CUserChatRoomGroupState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId              *uint64                     `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	TimeJoined               *uint32                     `protobuf:"varint,2,opt,name=time_joined,json=timeJoined" json:"time_joined,omitempty"`
+	UserChatRoomState        []*CUserChatRoomState       `protobuf:"bytes,3,rep,name=user_chat_room_state,json=userChatRoomState" json:"user_chat_room_state,omitempty"`
+	DesktopNotificationLevel *EChatRoomNotificationLevel `protobuf:"varint,4,opt,name=desktop_notification_level,json=desktopNotificationLevel,enum=EChatRoomNotificationLevel,def=0" json:"desktop_notification_level,omitempty"`
+	MobileNotificationLevel  *EChatRoomNotificationLevel `protobuf:"varint,5,opt,name=mobile_notification_level,json=mobileNotificationLevel,enum=EChatRoomNotificationLevel,def=0" json:"mobile_notification_level,omitempty"`
+	TimeLastGroupAck         *uint32                     `protobuf:"varint,6,opt,name=time_last_group_ack,json=timeLastGroupAck" json:"time_last_group_ack,omitempty"`
+	UnreadIndicatorMuted     *bool                       `protobuf:"varint,7,opt,name=unread_indicator_muted,json=unreadIndicatorMuted,def=0" json:"unread_indicator_muted,omitempty"`
+}
+
+// Default values for CUserChatRoomGroupState fields.
+const (
+	Default_CUserChatRoomGroupState_DesktopNotificationLevel = EChatRoomNotificationLevel_k_EChatroomNotificationLevel_Invalid
+	Default_CUserChatRoomGroupState_MobileNotificationLevel  = EChatRoomNotificationLevel_k_EChatroomNotificationLevel_Invalid
+	Default_CUserChatRoomGroupState_UnreadIndicatorMuted     = bool(false)
+)
+
+func (x *CUserChatRoomGroupState) Reset() {
+	*x = CUserChatRoomGroupState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CUserChatRoomGroupState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CUserChatRoomGroupState) ProtoMessage() {}
+
+func (x *CUserChatRoomGroupState) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CUserChatRoomGroupState.ProtoReflect.Descriptor instead.
+func (*CUserChatRoomGroupState) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CUserChatRoomGroupState) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CUserChatRoomGroupState) GetTimeJoined() uint32 {
+	if x != nil && x.TimeJoined != nil {
+		return *x.TimeJoined
+	}
+	return 0
+}
+
+func (x *CUserChatRoomGroupState) GetUserChatRoomState() []*CUserChatRoomState {
+	if x != nil {
+		return x.UserChatRoomState
+	}
+	return nil
+}
+
+func (x *CUserChatRoomGroupState) GetDesktopNotificationLevel() EChatRoomNotificationLevel {
+	if x != nil && x.DesktopNotificationLevel != nil {
+		return *x.DesktopNotificationLevel
+	}
+	return Default_CUserChatRoomGroupState_DesktopNotificationLevel
+}
+
+func (x *CUserChatRoomGroupState) GetMobileNotificationLevel() EChatRoomNotificationLevel {
+	if x != nil && x.MobileNotificationLevel != nil {
+		return *x.MobileNotificationLevel
+	}
+	return Default_CUserChatRoomGroupState_MobileNotificationLevel
+}
+
+func (x *CUserChatRoomGroupState) GetTimeLastGroupAck() uint32 {
+	if x != nil && x.TimeLastGroupAck != nil {
+		return *x.TimeLastGroupAck
+	}
+	return 0
+}
+
+func (x *CUserChatRoomGroupState) GetUnreadIndicatorMuted() bool {
+	if x != nil && x.UnreadIndicatorMuted != nil {
+		return *x.UnreadIndicatorMuted
+	}
+	return Default_CUserChatRoomGroupState_UnreadIndicatorMuted
+}
+
+type CChatRoomGroupSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId                *uint64             `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	ChatGroupName              *string             `protobuf:"bytes,2,opt,name=chat_group_name,json=chatGroupName" json:"chat_group_name,omitempty"`
+	ActiveMemberCount          *uint32             `protobuf:"varint,3,opt,name=active_member_count,json=activeMemberCount" json:"active_member_count,omitempty"`
+	ActiveVoiceMemberCount     *uint32             `protobuf:"varint,4,opt,name=active_voice_member_count,json=activeVoiceMemberCount" json:"active_voice_member_count,omitempty"`
+	DefaultChatId              *uint64             `protobuf:"varint,5,opt,name=default_chat_id,json=defaultChatId" json:"default_chat_id,omitempty"`
+	ChatRooms                  []*CChatRoomState   `protobuf:"bytes,6,rep,name=chat_rooms,json=chatRooms" json:"chat_rooms,omitempty"`
+	Clanid                     *uint32             `protobuf:"varint,7,opt,name=clanid" json:"clanid,omitempty"`
+	ChatGroupTagline           *string             `protobuf:"bytes,8,opt,name=chat_group_tagline,json=chatGroupTagline" json:"chat_group_tagline,omitempty"`
+	AccountidOwner             *uint32             `protobuf:"varint,9,opt,name=accountid_owner,json=accountidOwner" json:"accountid_owner,omitempty"`
+	TopMembers                 []uint32            `protobuf:"varint,10,rep,name=top_members,json=topMembers" json:"top_members,omitempty"`
+	ChatGroupAvatarSha         []byte              `protobuf:"bytes,11,opt,name=chat_group_avatar_sha,json=chatGroupAvatarSha" json:"chat_group_avatar_sha,omitempty"`
+	Rank                       *EChatRoomGroupRank `protobuf:"varint,12,opt,name=rank,enum=EChatRoomGroupRank,def=0" json:"rank,omitempty"`
+	DefaultRoleId              *uint64             `protobuf:"varint,13,opt,name=default_role_id,json=defaultRoleId" json:"default_role_id,omitempty"`
+	RoleIds                    []uint64            `protobuf:"varint,14,rep,name=role_ids,json=roleIds" json:"role_ids,omitempty"`
+	RoleActions                *CChatRoleActions   `protobuf:"bytes,15,opt,name=role_actions,json=roleActions" json:"role_actions,omitempty"`
+	WatchingBroadcastAccountid *uint32             `protobuf:"varint,16,opt,name=watching_broadcast_accountid,json=watchingBroadcastAccountid" json:"watching_broadcast_accountid,omitempty"`
+	Appid                      *uint32             `protobuf:"varint,17,opt,name=appid" json:"appid,omitempty"`
+	WatchingBroadcastChannelId *uint64             `protobuf:"varint,19,opt,name=watching_broadcast_channel_id,json=watchingBroadcastChannelId" json:"watching_broadcast_channel_id,omitempty"`
+	ActiveMinigameId           *uint64             `protobuf:"varint,20,opt,name=active_minigame_id,json=activeMinigameId" json:"active_minigame_id,omitempty"`
+	AvatarUgcUrl               *string             `protobuf:"bytes,21,opt,name=avatar_ugc_url,json=avatarUgcUrl" json:"avatar_ugc_url,omitempty"`
+	Disabled                   *bool               `protobuf:"varint,22,opt,name=disabled" json:"disabled,omitempty"`
+}
+
+// Default values for CChatRoomGroupSummaryResponse fields.
+const (
+	Default_CChatRoomGroupSummaryResponse_Rank = EChatRoomGroupRank_k_EChatRoomGroupRank_Default
+)
+
+func (x *CChatRoomGroupSummaryResponse) Reset() {
+	*x = CChatRoomGroupSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomGroupSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomGroupSummaryResponse) ProtoMessage() {}
+
+func (x *CChatRoomGroupSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomGroupSummaryResponse.ProtoReflect.Descriptor instead.
+func (*CChatRoomGroupSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetChatGroupName() string {
+	if x != nil && x.ChatGroupName != nil {
+		return *x.ChatGroupName
+	}
+	return ""
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetActiveMemberCount() uint32 {
+	if x != nil && x.ActiveMemberCount != nil {
+		return *x.ActiveMemberCount
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetActiveVoiceMemberCount() uint32 {
+	if x != nil && x.ActiveVoiceMemberCount != nil {
+		return *x.ActiveVoiceMemberCount
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetDefaultChatId() uint64 {
+	if x != nil && x.DefaultChatId != nil {
+		return *x.DefaultChatId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetChatRooms() []*CChatRoomState {
+	if x != nil {
+		return x.ChatRooms
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetClanid() uint32 {
+	if x != nil && x.Clanid != nil {
+		return *x.Clanid
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetChatGroupTagline() string {
+	if x != nil && x.ChatGroupTagline != nil {
+		return *x.ChatGroupTagline
+	}
+	return ""
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetAccountidOwner() uint32 {
+	if x != nil && x.AccountidOwner != nil {
+		return *x.AccountidOwner
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetTopMembers() []uint32 {
+	if x != nil {
+		return x.TopMembers
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetChatGroupAvatarSha() []byte {
+	if x != nil {
+		return x.ChatGroupAvatarSha
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetRank() EChatRoomGroupRank {
+	if x != nil && x.Rank != nil {
+		return *x.Rank
+	}
+	return Default_CChatRoomGroupSummaryResponse_Rank
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetDefaultRoleId() uint64 {
+	if x != nil && x.DefaultRoleId != nil {
+		return *x.DefaultRoleId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetRoleIds() []uint64 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetRoleActions() *CChatRoleActions {
+	if x != nil {
+		return x.RoleActions
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetWatchingBroadcastAccountid() uint32 {
+	if x != nil && x.WatchingBroadcastAccountid != nil {
+		return *x.WatchingBroadcastAccountid
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetWatchingBroadcastChannelId() uint64 {
+	if x != nil && x.WatchingBroadcastChannelId != nil {
+		return *x.WatchingBroadcastChannelId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetActiveMinigameId() uint64 {
+	if x != nil && x.ActiveMinigameId != nil {
+		return *x.ActiveMinigameId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetAvatarUgcUrl() string {
+	if x != nil && x.AvatarUgcUrl != nil {
+		return *x.AvatarUgcUrl
+	}
+	return ""
+}
+
+func (x *CChatRoomGroupSummaryResponse) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+type CChatRoomSummaryPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserChatGroupState *CUserChatRoomGroupState       `protobuf:"bytes,1,opt,name=user_chat_group_state,json=userChatGroupState" json:"user_chat_group_state,omitempty"`
+	GroupSummary       *CChatRoomGroupSummaryResponse `protobuf:"bytes,2,opt,name=group_summary,json=groupSummary" json:"group_summary,omitempty"`
+}
+
+func (x *CChatRoomSummaryPair) Reset() {
+	*x = CChatRoomSummaryPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomSummaryPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomSummaryPair) ProtoMessage() {}
+
+func (x *CChatRoomSummaryPair) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomSummaryPair.ProtoReflect.Descriptor instead.
+func (*CChatRoomSummaryPair) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CChatRoomSummaryPair) GetUserChatGroupState() *CUserChatRoomGroupState {
+	if x != nil {
+		return x.UserChatGroupState
+	}
+	return nil
+}
+
+func (x *CChatRoomSummaryPair) GetGroupSummary() *CChatRoomGroupSummaryResponse {
+	if x != nil {
+		return x.GroupSummary
+	}
+	return nil
+}
+
+type CChatMentions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MentionAll        *bool    `protobuf:"varint,1,opt,name=mention_all,json=mentionAll" json:"mention_all,omitempty"`
+	MentionHere       *bool    `protobuf:"varint,2,opt,name=mention_here,json=mentionHere" json:"mention_here,omitempty"`
+	MentionAccountids []uint32 `protobuf:"varint,3,rep,name=mention_accountids,json=mentionAccountids" json:"mention_accountids,omitempty"`
+}
+
+func (x *CChatMentions) Reset() {
+	*x = CChatMentions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatMentions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatMentions) ProtoMessage() {}
+
+func (x *CChatMentions) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatMentions.ProtoReflect.Descriptor instead.
+func (*CChatMentions) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CChatMentions) GetMentionAll() bool {
+	if x != nil && x.MentionAll != nil {
+		return *x.MentionAll
+	}
+	return false
+}
+
+func (x *CChatMentions) GetMentionHere() bool {
+	if x != nil && x.MentionHere != nil {
+		return *x.MentionHere
+	}
+	return false
+}
+
+func (x *CChatMentions) GetMentionAccountids() []uint32 {
+	if x != nil {
+		return x.MentionAccountids
+	}
+	return nil
+}
+
+type ServerMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message        *EChatRoomServerMessage `protobuf:"varint,1,opt,name=message,enum=EChatRoomServerMessage,def=0" json:"message,omitempty"`
+	StringParam    *string                 `protobuf:"bytes,2,opt,name=string_param,json=stringParam" json:"string_param,omitempty"`
+	AccountidParam *uint32                 `protobuf:"varint,3,opt,name=accountid_param,json=accountidParam" json:"accountid_param,omitempty"`
+}
+
+// Default values for ServerMessage fields.
+const (
+	Default_ServerMessage_Message = EChatRoomServerMessage_k_EChatRoomServerMsg_Invalid
+)
+
+func (x *ServerMessage) Reset() {
+	*x = ServerMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerMessage) ProtoMessage() {}
+
+func (x *ServerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
+func (*ServerMessage) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ServerMessage) GetMessage() EChatRoomServerMessage {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return Default_ServerMessage_Message
+}
+
+func (x *ServerMessage) GetStringParam() string {
+	if x != nil && x.StringParam != nil {
+		return *x.StringParam
+	}
+	return ""
+}
+
+func (x *ServerMessage) GetAccountidParam() uint32 {
+	if x != nil && x.AccountidParam != nil {
+		return *x.AccountidParam
+	}
+	return 0
+}
+
+type CChatRoomBan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accountid      *uint32 `protobuf:"varint,1,opt,name=accountid" json:"accountid,omitempty"`
+	AccountidActor *uint32 `protobuf:"varint,2,opt,name=accountid_actor,json=accountidActor" json:"accountid_actor,omitempty"`
+	TimeBanned     *uint32 `protobuf:"varint,3,opt,name=time_banned,json=timeBanned" json:"time_banned,omitempty"`
+	BanReason      *string `protobuf:"bytes,4,opt,name=ban_reason,json=banReason" json:"ban_reason,omitempty"`
+}
+
+func (x *CChatRoomBan) Reset() {
+	*x = CChatRoomBan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomBan) ProtoMessage() {}
+
+func (x *CChatRoomBan) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomBan.ProtoReflect.Descriptor instead.
+func (*CChatRoomBan) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CChatRoomBan) GetAccountid() uint32 {
+	if x != nil && x.Accountid != nil {
+		return *x.Accountid
+	}
+	return 0
+}
+
+func (x *CChatRoomBan) GetAccountidActor() uint32 {
+	if x != nil && x.AccountidActor != nil {
+		return *x.AccountidActor
+	}
+	return 0
+}
+
+func (x *CChatRoomBan) GetTimeBanned() uint32 {
+	if x != nil && x.TimeBanned != nil {
+		return *x.TimeBanned
+	}
+	return 0
+}
+
+func (x *CChatRoomBan) GetBanReason() string {
+	if x != nil && x.BanReason != nil {
+		return *x.BanReason
+	}
+	return ""
+}
+
+type CChatRoom_GetMyChatRoomGroups_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_GetMyChatRoomGroups_Request) Reset() {
+	*x = CChatRoom_GetMyChatRoomGroups_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetMyChatRoomGroups_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetMyChatRoomGroups_Request) ProtoMessage() {}
+
+func (x *CChatRoom_GetMyChatRoomGroups_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetMyChatRoomGroups_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetMyChatRoomGroups_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{12}
+}
+
+type CChatRoom_GetMyChatRoomGroups_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatRoomGroups []*CChatRoomSummaryPair `protobuf:"bytes,1,rep,name=chat_room_groups,json=chatRoomGroups" json:"chat_room_groups,omitempty"`
+}
+
+func (x *CChatRoom_GetMyChatRoomGroups_Response) Reset() {
+	*x = CChatRoom_GetMyChatRoomGroups_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetMyChatRoomGroups_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetMyChatRoomGroups_Response) ProtoMessage() {}
+
+func (x *CChatRoom_GetMyChatRoomGroups_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetMyChatRoomGroups_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetMyChatRoomGroups_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CChatRoom_GetMyChatRoomGroups_Response) GetChatRoomGroups() []*CChatRoomSummaryPair {
+	if x != nil {
+		return x.ChatRoomGroups
+	}
+	return nil
+}
+
+type CChatRoom_GetChatRoomGroupState_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+}
+
+func (x *CChatRoom_GetChatRoomGroupState_Request) Reset() {
+	*x = CChatRoom_GetChatRoomGroupState_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetChatRoomGroupState_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetChatRoomGroupState_Request) ProtoMessage() {}
+
+func (x *CChatRoom_GetChatRoomGroupState_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetChatRoomGroupState_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetChatRoomGroupState_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CChatRoom_GetChatRoomGroupState_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+type CChatRoom_GetChatRoomGroupState_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *CChatRoomGroupState `protobuf:"bytes,1,opt,name=state" json:"state,omitempty"`
+}
+
+func (x *CChatRoom_GetChatRoomGroupState_Response) Reset() {
+	*x = CChatRoom_GetChatRoomGroupState_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetChatRoomGroupState_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetChatRoomGroupState_Response) ProtoMessage() {}
+
+func (x *CChatRoom_GetChatRoomGroupState_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetChatRoomGroupState_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetChatRoomGroupState_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CChatRoom_GetChatRoomGroupState_Response) GetState() *CChatRoomGroupState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type CChatRoomGroupState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeaderState   *CChatRoomGroupHeaderState `protobuf:"bytes,1,opt,name=header_state,json=headerState" json:"header_state,omitempty"`
+	Members       []*CChatRoomMember         `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
+	DefaultChatId *uint64                    `protobuf:"varint,4,opt,name=default_chat_id,json=defaultChatId" json:"default_chat_id,omitempty"`
+	ChatRooms     []*CChatRoomState          `protobuf:"bytes,5,rep,name=chat_rooms,json=chatRooms" json:"chat_rooms,omitempty"`
+	Kicked        []*CChatRoomMember         `protobuf:"bytes,7,rep,name=kicked" json:"kicked,omitempty"`
+}
+
+func (x *CChatRoomGroupState) Reset() {
+	*x = CChatRoomGroupState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomGroupState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomGroupState) ProtoMessage() {}
+
+func (x *CChatRoomGroupState) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomGroupState.ProtoReflect.Descriptor instead.
+func (*CChatRoomGroupState) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CChatRoomGroupState) GetHeaderState() *CChatRoomGroupHeaderState {
+	if x != nil {
+		return x.HeaderState
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupState) GetMembers() []*CChatRoomMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupState) GetDefaultChatId() uint64 {
+	if x != nil && x.DefaultChatId != nil {
+		return *x.DefaultChatId
+	}
+	return 0
+}
+
+func (x *CChatRoomGroupState) GetChatRooms() []*CChatRoomState {
+	if x != nil {
+		return x.ChatRooms
+	}
+	return nil
+}
+
+func (x *CChatRoomGroupState) GetKicked() []*CChatRoomMember {
+	if x != nil {
+		return x.Kicked
+	}
+	return nil
+}
+
+type CChatRoom_JoinChatRoomGroup_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	InviteCode  *string `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode" json:"invite_code,omitempty"`
+	ChatId      *uint64 `protobuf:"varint,3,opt,name=chat_id,json=chatId" json:"chat_id,omitempty"`
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Request) Reset() {
+	*x = CChatRoom_JoinChatRoomGroup_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_JoinChatRoomGroup_Request) ProtoMessage() {}
+
+func (x *CChatRoom_JoinChatRoomGroup_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_JoinChatRoomGroup_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_JoinChatRoomGroup_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Request) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
+	}
+	return ""
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Request) GetChatId() uint64 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+type CChatRoom_JoinChatRoomGroup_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State         *CChatRoomGroupSummaryResponse `protobuf:"bytes,1,opt,name=state" json:"state,omitempty"`
+	UserChatState *CUserChatRoomGroupState       `protobuf:"bytes,3,opt,name=user_chat_state,json=userChatState" json:"user_chat_state,omitempty"`
+	JoinChatId    *uint64                        `protobuf:"varint,4,opt,name=join_chat_id,json=joinChatId" json:"join_chat_id,omitempty"`
+	TimeExpire    *uint32                        `protobuf:"varint,5,opt,name=time_expire,json=timeExpire" json:"time_expire,omitempty"`
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Response) Reset() {
+	*x = CChatRoom_JoinChatRoomGroup_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_JoinChatRoomGroup_Response) ProtoMessage() {}
+
+func (x *CChatRoom_JoinChatRoomGroup_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_JoinChatRoomGroup_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_JoinChatRoomGroup_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Response) GetState() *CChatRoomGroupSummaryResponse {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Response) GetUserChatState() *CUserChatRoomGroupState {
+	if x != nil {
+		return x.UserChatState
+	}
+	return nil
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Response) GetJoinChatId() uint64 {
+	if x != nil && x.JoinChatId != nil {
+		return *x.JoinChatId
+	}
+	return 0
+}
+
+func (x *CChatRoom_JoinChatRoomGroup_Response) GetTimeExpire() uint32 {
+	if x != nil && x.TimeExpire != nil {
+		return *x.TimeExpire
+	}
+	return 0
+}
+
+type CChatRoom_LeaveChatRoomGroup_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+}
+
+func (x *CChatRoom_LeaveChatRoomGroup_Request) Reset() {
+	*x = CChatRoom_LeaveChatRoomGroup_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_LeaveChatRoomGroup_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_LeaveChatRoomGroup_Request) ProtoMessage() {}
+
+func (x *CChatRoom_LeaveChatRoomGroup_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_LeaveChatRoomGroup_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_LeaveChatRoomGroup_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CChatRoom_LeaveChatRoomGroup_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+type CChatRoom_LeaveChatRoomGroup_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_LeaveChatRoomGroup_Response) Reset() {
+	*x = CChatRoom_LeaveChatRoomGroup_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_LeaveChatRoomGroup_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_LeaveChatRoomGroup_Response) ProtoMessage() {}
+
+func (x *CChatRoom_LeaveChatRoomGroup_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_LeaveChatRoomGroup_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_LeaveChatRoomGroup_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{20}
+}
+
+type CChatRoom_SendChatMessage_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId  *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	ChatId       *uint64 `protobuf:"varint,2,opt,name=chat_id,json=chatId" json:"chat_id,omitempty"`
+	Message      *string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+	EchoToSender *bool   `protobuf:"varint,4,opt,name=echo_to_sender,json=echoToSender" json:"echo_to_sender,omitempty"`
+}
+
+func (x *CChatRoom_SendChatMessage_Request) Reset() {
+	*x = CChatRoom_SendChatMessage_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_SendChatMessage_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_SendChatMessage_Request) ProtoMessage() {}
+
+func (x *CChatRoom_SendChatMessage_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_SendChatMessage_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_SendChatMessage_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CChatRoom_SendChatMessage_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_SendChatMessage_Request) GetChatId() uint64 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *CChatRoom_SendChatMessage_Request) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *CChatRoom_SendChatMessage_Request) GetEchoToSender() bool {
+	if x != nil && x.EchoToSender != nil {
+		return *x.EchoToSender
+	}
+	return false
+}
+
+type CChatRoom_SendChatMessage_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModifiedMessage      *string `protobuf:"bytes,1,opt,name=modified_message,json=modifiedMessage" json:"modified_message,omitempty"`
+	ServerTimestamp      *uint32 `protobuf:"varint,2,opt,name=server_timestamp,json=serverTimestamp" json:"server_timestamp,omitempty"`
+	Ordinal              *uint32 `protobuf:"varint,3,opt,name=ordinal" json:"ordinal,omitempty"`
+	MessageWithoutBbCode *string `protobuf:"bytes,4,opt,name=message_without_bb_code,json=messageWithoutBbCode" json:"message_without_bb_code,omitempty"`
+}
+
+func (x *CChatRoom_SendChatMessage_Response) Reset() {
+	*x = CChatRoom_SendChatMessage_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_SendChatMessage_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_SendChatMessage_Response) ProtoMessage() {}
+
+func (x *CChatRoom_SendChatMessage_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_SendChatMessage_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_SendChatMessage_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CChatRoom_SendChatMessage_Response) GetModifiedMessage() string {
+	if x != nil && x.ModifiedMessage != nil {
+		return *x.ModifiedMessage
+	}
+	return ""
+}
+
+func (x *CChatRoom_SendChatMessage_Response) GetServerTimestamp() uint32 {
+	if x != nil && x.ServerTimestamp != nil {
+		return *x.ServerTimestamp
+	}
+	return 0
+}
+
+func (x *CChatRoom_SendChatMessage_Response) GetOrdinal() uint32 {
+	if x != nil && x.Ordinal != nil {
+		return *x.Ordinal
+	}
+	return 0
+}
+
+func (x *CChatRoom_SendChatMessage_Response) GetMessageWithoutBbCode() string {
+	if x != nil && x.MessageWithoutBbCode != nil {
+		return *x.MessageWithoutBbCode
+	}
+	return ""
+}
+
+type CChatRoom_AckChatMessage_Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	ChatId      *uint64 `protobuf:"varint,2,opt,name=chat_id,json=chatId" json:"chat_id,omitempty"`
+	Timestamp   *uint32 `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (x *CChatRoom_AckChatMessage_Notification) Reset() {
+	*x = CChatRoom_AckChatMessage_Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_AckChatMessage_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_AckChatMessage_Notification) ProtoMessage() {}
+
+func (x *CChatRoom_AckChatMessage_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_AckChatMessage_Notification.ProtoReflect.Descriptor instead.
+func (*CChatRoom_AckChatMessage_Notification) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CChatRoom_AckChatMessage_Notification) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_AckChatMessage_Notification) GetChatId() uint64 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *CChatRoom_AckChatMessage_Notification) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+type CChatRoom_CreateInviteLink_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId  *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	SecondsValid *uint32 `protobuf:"varint,2,opt,name=seconds_valid,json=secondsValid" json:"seconds_valid,omitempty"`
+	ChatId       *uint64 `protobuf:"varint,3,opt,name=chat_id,json=chatId" json:"chat_id,omitempty"`
+}
+
+func (x *CChatRoom_CreateInviteLink_Request) Reset() {
+	*x = CChatRoom_CreateInviteLink_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_CreateInviteLink_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_CreateInviteLink_Request) ProtoMessage() {}
+
+func (x *CChatRoom_CreateInviteLink_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_CreateInviteLink_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_CreateInviteLink_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CChatRoom_CreateInviteLink_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_CreateInviteLink_Request) GetSecondsValid() uint32 {
+	if x != nil && x.SecondsValid != nil {
+		return *x.SecondsValid
+	}
+	return 0
+}
+
+func (x *CChatRoom_CreateInviteLink_Request) GetChatId() uint64 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+type CChatRoom_CreateInviteLink_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviteCode   *string `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode" json:"invite_code,omitempty"`
+	SecondsValid *uint32 `protobuf:"varint,2,opt,name=seconds_valid,json=secondsValid" json:"seconds_valid,omitempty"`
+}
+
+func (x *CChatRoom_CreateInviteLink_Response) Reset() {
+	*x = CChatRoom_CreateInviteLink_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_CreateInviteLink_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_CreateInviteLink_Response) ProtoMessage() {}
+
+func (x *CChatRoom_CreateInviteLink_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_CreateInviteLink_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_CreateInviteLink_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CChatRoom_CreateInviteLink_Response) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
+	}
+	return ""
+}
+
+func (x *CChatRoom_CreateInviteLink_Response) GetSecondsValid() uint32 {
+	if x != nil && x.SecondsValid != nil {
+		return *x.SecondsValid
+	}
+	return 0
+}
+
+type CChatRoom_DeleteInviteLink_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	InviteCode  *string `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode" json:"invite_code,omitempty"`
+}
+
+func (x *CChatRoom_DeleteInviteLink_Request) Reset() {
+	*x = CChatRoom_DeleteInviteLink_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_DeleteInviteLink_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_DeleteInviteLink_Request) ProtoMessage() {}
+
+func (x *CChatRoom_DeleteInviteLink_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_DeleteInviteLink_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_DeleteInviteLink_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CChatRoom_DeleteInviteLink_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_DeleteInviteLink_Request) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
+	}
+	return ""
+}
+
+type CChatRoom_DeleteInviteLink_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_DeleteInviteLink_Response) Reset() {
+	*x = CChatRoom_DeleteInviteLink_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_DeleteInviteLink_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_DeleteInviteLink_Response) ProtoMessage() {}
+
+func (x *CChatRoom_DeleteInviteLink_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_DeleteInviteLink_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_DeleteInviteLink_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{27}
+}
+
+type CChatRoom_KickUser_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	Steamid     *uint64 `protobuf:"fixed64,2,opt,name=steamid" json:"steamid,omitempty"`
+	Expiration  *int32  `protobuf:"varint,3,opt,name=expiration" json:"expiration,omitempty"`
+}
+
+func (x *CChatRoom_KickUser_Request) Reset() {
+	*x = CChatRoom_KickUser_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_KickUser_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_KickUser_Request) ProtoMessage() {}
+
+func (x *CChatRoom_KickUser_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_KickUser_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_KickUser_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CChatRoom_KickUser_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_KickUser_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CChatRoom_KickUser_Request) GetExpiration() int32 {
+	if x != nil && x.Expiration != nil {
+		return *x.Expiration
+	}
+	return 0
+}
+
+type CChatRoom_KickUser_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_KickUser_Response) Reset() {
+	*x = CChatRoom_KickUser_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_KickUser_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_KickUser_Response) ProtoMessage() {}
+
+func (x *CChatRoom_KickUser_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_KickUser_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_KickUser_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{29}
+}
+
+type CChatRoom_SetUserBanState_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	Steamid     *uint64 `protobuf:"fixed64,2,opt,name=steamid" json:"steamid,omitempty"`
+	BanState    *bool   `protobuf:"varint,3,opt,name=ban_state,json=banState" json:"ban_state,omitempty"`
+}
+
+func (x *CChatRoom_SetUserBanState_Request) Reset() {
+	*x = CChatRoom_SetUserBanState_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_SetUserBanState_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_SetUserBanState_Request) ProtoMessage() {}
+
+func (x *CChatRoom_SetUserBanState_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_SetUserBanState_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_SetUserBanState_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CChatRoom_SetUserBanState_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_SetUserBanState_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CChatRoom_SetUserBanState_Request) GetBanState() bool {
+	if x != nil && x.BanState != nil {
+		return *x.BanState
+	}
+	return false
+}
+
+type CChatRoom_SetUserBanState_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_SetUserBanState_Response) Reset() {
+	*x = CChatRoom_SetUserBanState_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_SetUserBanState_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_SetUserBanState_Response) ProtoMessage() {}
+
+func (x *CChatRoom_SetUserBanState_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_SetUserBanState_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_SetUserBanState_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{31}
+}
+
+type CChatRoom_GetBanList_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+}
+
+func (x *CChatRoom_GetBanList_Request) Reset() {
+	*x = CChatRoom_GetBanList_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetBanList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetBanList_Request) ProtoMessage() {}
+
+func (x *CChatRoom_GetBanList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetBanList_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetBanList_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CChatRoom_GetBanList_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+type CChatRoom_GetBanList_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bans []*CChatRoomBan `protobuf:"bytes,1,rep,name=bans" json:"bans,omitempty"`
+}
+
+func (x *CChatRoom_GetBanList_Response) Reset() {
+	*x = CChatRoom_GetBanList_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetBanList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetBanList_Response) ProtoMessage() {}
+
+func (x *CChatRoom_GetBanList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetBanList_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetBanList_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CChatRoom_GetBanList_Response) GetBans() []*CChatRoomBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type CChatRoom_GetRoles_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+}
+
+func (x *CChatRoom_GetRoles_Request) Reset() {
+	*x = CChatRoom_GetRoles_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetRoles_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetRoles_Request) ProtoMessage() {}
+
+func (x *CChatRoom_GetRoles_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetRoles_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetRoles_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CChatRoom_GetRoles_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+type CChatRoom_GetRoles_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*CChatRole `protobuf:"bytes,1,rep,name=roles" json:"roles,omitempty"`
+}
+
+func (x *CChatRoom_GetRoles_Response) Reset() {
+	*x = CChatRoom_GetRoles_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_GetRoles_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_GetRoles_Response) ProtoMessage() {}
+
+func (x *CChatRoom_GetRoles_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_GetRoles_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_GetRoles_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CChatRoom_GetRoles_Response) GetRoles() []*CChatRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type CChatRoom_CreateRole_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	Name        *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (x *CChatRoom_CreateRole_Request) Reset() {
+	*x = CChatRoom_CreateRole_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_CreateRole_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_CreateRole_Request) ProtoMessage() {}
+
+func (x *CChatRoom_CreateRole_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_CreateRole_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_CreateRole_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CChatRoom_CreateRole_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_CreateRole_Request) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type CChatRoom_CreateRole_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions *CChatRoleActions `protobuf:"bytes,2,opt,name=actions" json:"actions,omitempty"`
+}
+
+func (x *CChatRoom_CreateRole_Response) Reset() {
+	*x = CChatRoom_CreateRole_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_CreateRole_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_CreateRole_Response) ProtoMessage() {}
+
+func (x *CChatRoom_CreateRole_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_CreateRole_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_CreateRole_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CChatRoom_CreateRole_Response) GetActions() *CChatRoleActions {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type CChatRoom_DeleteRole_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	RoleId      *uint64 `protobuf:"varint,2,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+}
+
+func (x *CChatRoom_DeleteRole_Request) Reset() {
+	*x = CChatRoom_DeleteRole_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_DeleteRole_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_DeleteRole_Request) ProtoMessage() {}
+
+func (x *CChatRoom_DeleteRole_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_DeleteRole_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_DeleteRole_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CChatRoom_DeleteRole_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_DeleteRole_Request) GetRoleId() uint64 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+type CChatRoom_DeleteRole_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_DeleteRole_Response) Reset() {
+	*x = CChatRoom_DeleteRole_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_DeleteRole_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_DeleteRole_Response) ProtoMessage() {}
+
+func (x *CChatRoom_DeleteRole_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_DeleteRole_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_DeleteRole_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{39}
+}
+
+type CChatRoom_RenameRole_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	RoleId      *uint64 `protobuf:"varint,2,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	Name        *string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+}
+
+func (x *CChatRoom_RenameRole_Request) Reset() {
+	*x = CChatRoom_RenameRole_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_RenameRole_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_RenameRole_Request) ProtoMessage() {}
+
+func (x *CChatRoom_RenameRole_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_RenameRole_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_RenameRole_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CChatRoom_RenameRole_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_RenameRole_Request) GetRoleId() uint64 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+func (x *CChatRoom_RenameRole_Request) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type CChatRoom_RenameRole_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_RenameRole_Response) Reset() {
+	*x = CChatRoom_RenameRole_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_RenameRole_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_RenameRole_Response) ProtoMessage() {}
+
+func (x *CChatRoom_RenameRole_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_RenameRole_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_RenameRole_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{41}
+}
+
+type CChatRoom_AddRoleToUser_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	RoleId      *uint64 `protobuf:"varint,3,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	Steamid     *uint64 `protobuf:"fixed64,4,opt,name=steamid" json:"steamid,omitempty"`
+}
+
+func (x *CChatRoom_AddRoleToUser_Request) Reset() {
+	*x = CChatRoom_AddRoleToUser_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_AddRoleToUser_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_AddRoleToUser_Request) ProtoMessage() {}
+
+func (x *CChatRoom_AddRoleToUser_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_AddRoleToUser_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_AddRoleToUser_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CChatRoom_AddRoleToUser_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_AddRoleToUser_Request) GetRoleId() uint64 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+func (x *CChatRoom_AddRoleToUser_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+type CChatRoom_AddRoleToUser_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_AddRoleToUser_Response) Reset() {
+	*x = CChatRoom_AddRoleToUser_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_AddRoleToUser_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_AddRoleToUser_Response) ProtoMessage() {}
+
+func (x *CChatRoom_AddRoleToUser_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_AddRoleToUser_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_AddRoleToUser_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{43}
+}
+
+type CChatRoom_DeleteRoleFromUser_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64 `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	RoleId      *uint64 `protobuf:"varint,3,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	Steamid     *uint64 `protobuf:"fixed64,4,opt,name=steamid" json:"steamid,omitempty"`
+}
+
+func (x *CChatRoom_DeleteRoleFromUser_Request) Reset() {
+	*x = CChatRoom_DeleteRoleFromUser_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_DeleteRoleFromUser_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_DeleteRoleFromUser_Request) ProtoMessage() {}
+
+func (x *CChatRoom_DeleteRoleFromUser_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_DeleteRoleFromUser_Request.ProtoReflect.Descriptor instead.
+func (*CChatRoom_DeleteRoleFromUser_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CChatRoom_DeleteRoleFromUser_Request) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_DeleteRoleFromUser_Request) GetRoleId() uint64 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+func (x *CChatRoom_DeleteRoleFromUser_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+type CChatRoom_DeleteRoleFromUser_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CChatRoom_DeleteRoleFromUser_Response) Reset() {
+	*x = CChatRoom_DeleteRoleFromUser_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_DeleteRoleFromUser_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_DeleteRoleFromUser_Response) ProtoMessage() {}
+
+func (x *CChatRoom_DeleteRoleFromUser_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_DeleteRoleFromUser_Response.ProtoReflect.Descriptor instead.
+func (*CChatRoom_DeleteRoleFromUser_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{45}
+}
+
+type CChatRoom_IncomingChatMessage_Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId     *uint64        `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	ChatId          *uint64        `protobuf:"varint,2,opt,name=chat_id,json=chatId" json:"chat_id,omitempty"`
+	SteamidSender   *uint64        `protobuf:"fixed64,3,opt,name=steamid_sender,json=steamidSender" json:"steamid_sender,omitempty"`
+	Message         *string        `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	Timestamp       *uint32        `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	Mentions        *CChatMentions `protobuf:"bytes,6,opt,name=mentions" json:"mentions,omitempty"`
+	Ordinal         *uint32        `protobuf:"varint,7,opt,name=ordinal" json:"ordinal,omitempty"`
+	ServerMessage   *ServerMessage `protobuf:"bytes,8,opt,name=server_message,json=serverMessage" json:"server_message,omitempty"`
+	MessageNoBbcode *string        `protobuf:"bytes,9,opt,name=message_no_bbcode,json=messageNoBbcode" json:"message_no_bbcode,omitempty"`
+	ChatName        *string        `protobuf:"bytes,10,opt,name=chat_name,json=chatName" json:"chat_name,omitempty"`
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) Reset() {
+	*x = CChatRoom_IncomingChatMessage_Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_IncomingChatMessage_Notification) ProtoMessage() {}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_IncomingChatMessage_Notification.ProtoReflect.Descriptor instead.
+func (*CChatRoom_IncomingChatMessage_Notification) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetChatId() uint64 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetSteamidSender() uint64 {
+	if x != nil && x.SteamidSender != nil {
+		return *x.SteamidSender
+	}
+	return 0
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetMentions() *CChatMentions {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetOrdinal() uint32 {
+	if x != nil && x.Ordinal != nil {
+		return *x.Ordinal
+	}
+	return 0
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetServerMessage() *ServerMessage {
+	if x != nil {
+		return x.ServerMessage
+	}
+	return nil
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetMessageNoBbcode() string {
+	if x != nil && x.MessageNoBbcode != nil {
+		return *x.MessageNoBbcode
+	}
+	return ""
+}
+
+func (x *CChatRoom_IncomingChatMessage_Notification) GetChatName() string {
+	if x != nil && x.ChatName != nil {
+		return *x.ChatName
+	}
+	return ""
+}
+
+type CChatRoom_MemberStateChange_Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId *uint64                     `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	Member      *CChatRoomMember            `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	Change      *EChatRoomMemberStateChange `protobuf:"varint,3,opt,name=change,enum=EChatRoomMemberStateChange,def=0" json:"change,omitempty"`
+}
+
+// Default values for CChatRoom_MemberStateChange_Notification fields.
+const (
+	Default_CChatRoom_MemberStateChange_Notification_Change = EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Invalid
+)
+
+func (x *CChatRoom_MemberStateChange_Notification) Reset() {
+	*x = CChatRoom_MemberStateChange_Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_MemberStateChange_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_MemberStateChange_Notification) ProtoMessage() {}
+
+func (x *CChatRoom_MemberStateChange_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_MemberStateChange_Notification.ProtoReflect.Descriptor instead.
+func (*CChatRoom_MemberStateChange_Notification) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CChatRoom_MemberStateChange_Notification) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_MemberStateChange_Notification) GetMember() *CChatRoomMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *CChatRoom_MemberStateChange_Notification) GetChange() EChatRoomMemberStateChange {
+	if x != nil && x.Change != nil {
+		return *x.Change
+	}
+	return Default_CChatRoom_MemberStateChange_Notification_Change
+}
+
+type CChatRoom_ChatRoomHeaderState_Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeaderState *CChatRoomGroupHeaderState `protobuf:"bytes,1,opt,name=header_state,json=headerState" json:"header_state,omitempty"`
+}
+
+func (x *CChatRoom_ChatRoomHeaderState_Notification) Reset() {
+	*x = CChatRoom_ChatRoomHeaderState_Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_ChatRoomHeaderState_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_ChatRoomHeaderState_Notification) ProtoMessage() {}
+
+func (x *CChatRoom_ChatRoomHeaderState_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_ChatRoomHeaderState_Notification.ProtoReflect.Descriptor instead.
+func (*CChatRoom_ChatRoomHeaderState_Notification) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CChatRoom_ChatRoomHeaderState_Notification) GetHeaderState() *CChatRoomGroupHeaderState {
+	if x != nil {
+		return x.HeaderState
+	}
+	return nil
+}
+
+type CChatRoom_ChatRoomGroupRoomsChange_Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId   *uint64           `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	DefaultChatId *uint64           `protobuf:"varint,2,opt,name=default_chat_id,json=defaultChatId" json:"default_chat_id,omitempty"`
+	ChatRooms     []*CChatRoomState `protobuf:"bytes,3,rep,name=chat_rooms,json=chatRooms" json:"chat_rooms,omitempty"`
+}
+
+func (x *CChatRoom_ChatRoomGroupRoomsChange_Notification) Reset() {
+	*x = CChatRoom_ChatRoomGroupRoomsChange_Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoom_ChatRoomGroupRoomsChange_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoom_ChatRoomGroupRoomsChange_Notification) ProtoMessage() {}
+
+func (x *CChatRoom_ChatRoomGroupRoomsChange_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoom_ChatRoomGroupRoomsChange_Notification.ProtoReflect.Descriptor instead.
+func (*CChatRoom_ChatRoomGroupRoomsChange_Notification) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CChatRoom_ChatRoomGroupRoomsChange_Notification) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoom_ChatRoomGroupRoomsChange_Notification) GetDefaultChatId() uint64 {
+	if x != nil && x.DefaultChatId != nil {
+		return *x.DefaultChatId
+	}
+	return 0
+}
+
+func (x *CChatRoom_ChatRoomGroupRoomsChange_Notification) GetChatRooms() []*CChatRoomState {
+	if x != nil {
+		return x.ChatRooms
+	}
+	return nil
+}
+
+type CChatRoomClient_NotifyChatGroupUserStateChanged_Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatGroupId        *uint64                        `protobuf:"varint,1,opt,name=chat_group_id,json=chatGroupId" json:"chat_group_id,omitempty"`
+	UserChatGroupState *CUserChatRoomGroupState       `protobuf:"bytes,2,opt,name=user_chat_group_state,json=userChatGroupState" json:"user_chat_group_state,omitempty"`
+	GroupSummary       *CChatRoomGroupSummaryResponse `protobuf:"bytes,3,opt,name=group_summary,json=groupSummary" json:"group_summary,omitempty"`
+	UserAction         *EChatRoomMemberStateChange    `protobuf:"varint,4,opt,name=user_action,json=userAction,enum=EChatRoomMemberStateChange,def=0" json:"user_action,omitempty"`
+}
+
+// Default values for CChatRoomClient_NotifyChatGroupUserStateChanged_Notification fields.
+const (
+	Default_CChatRoomClient_NotifyChatGroupUserStateChanged_Notification_UserAction = EChatRoomMemberStateChange_k_EChatRoomMemberStateChange_Invalid
+)
+
+func (x *CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) Reset() {
+	*x = CChatRoomClient_NotifyChatGroupUserStateChanged_Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_chat_steamclient_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) ProtoMessage() {}
+
+func (x *CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_chat_steamclient_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChatRoomClient_NotifyChatGroupUserStateChanged_Notification.ProtoReflect.Descriptor instead.
+func (*CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) Descriptor() ([]byte, []int) {
+	return file_steammessages_chat_steamclient_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) GetChatGroupId() uint64 {
+	if x != nil && x.ChatGroupId != nil {
+		return *x.ChatGroupId
+	}
+	return 0
+}
+
+func (x *CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) GetUserChatGroupState() *CUserChatRoomGroupState {
+	if x != nil {
+		return x.UserChatGroupState
+	}
+	return nil
+}
+
+func (x *CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) GetGroupSummary() *CChatRoomGroupSummaryResponse {
+	if x != nil {
+		return x.GroupSummary
+	}
+	return nil
+}
+
+func (x *CChatRoomClient_NotifyChatGroupUserStateChanged_Notification) GetUserAction() EChatRoomMemberStateChange {
+	if x != nil && x.UserAction != nil {
+		return *x.UserAction
+	}
+	return Default_CChatRoomClient_NotifyChatGroupUserStateChanged_Notification_UserAction
+}
+
+var File_steammessages_chat_steamclient_proto protoreflect.FileDescriptor
+
+var file_steammessages_chat_steamclient_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2c, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x65,
+	0x61, 0x6d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c,
+	0x04, 0x0a, 0x10, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x20,
+	0x63, 0x61, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x63, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x6b, 0x69, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x4b, 0x69, 0x63, 0x6b, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x5f, 0x62, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x63, 0x61, 0x6e, 0x42, 0x61, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x5f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x1e, 0x63, 0x61, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x1a, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x67, 0x6c, 0x69, 0x6e,
+	0x65, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x5f, 0x76, 0x69,
+	0x65, 0x77, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x63, 0x61, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x33, 0x0a, 0x16, 0x63, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x5f,
+	0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c,
+	0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0x52, 0x0a,
+	0x09, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x6c, 0x22, 0xb9, 0x02, 0x0a, 0x0e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x49, 0x6e, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x69, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe1, 0x04,
+	0x0a, 0x19, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6c, 0x61, 0x6e, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6c,
+	0x61, 0x6e, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x64, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x70,
+	0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x1c,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x1a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x12, 0x41,
+	0x0a, 0x1d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x67, 0x63, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55,
+	0x67, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x84, 0x02, 0x0a, 0x0f, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4a, 0x6f,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x45, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x3a,
+	0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x61, 0x6e, 0x6b, 0x5f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6b, 0x69, 0x63, 0x6b,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x4b, 0x69, 0x63, 0x6b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0x87, 0x04, 0x0a, 0x12, 0x43, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x7f, 0x0a,
+	0x1a, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x24,
+	0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x52, 0x18, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x7d,
+	0x0a, 0x19, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x24,
+	0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x52, 0x17, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2a, 0x0a,
+	0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x61,
+	0x73, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x16, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x75,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x52, 0x14, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x22, 0x90, 0x04, 0x0a, 0x17, 0x43, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x43, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x64, 0x65, 0x73,
+	0x6b, 0x74, 0x6f, 0x70, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x24, 0x6b, 0x5f, 0x45, 0x43,
+	0x68, 0x61, 0x74, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x52, 0x18, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x7d, 0x0a, 0x19, 0x6d, 0x6f,
+	0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x24, 0x6b, 0x5f, 0x45, 0x43,
+	0x68, 0x61, 0x74, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x52, 0x17, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x63, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x61, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x16, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x75, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x14, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x4d, 0x75, 0x74, 0x65, 0x64, 0x22, 0xbc, 0x07, 0x0a, 0x1d, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f,
+	0x69, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x72,
+	0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x6e, 0x69, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x61, 0x67,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x61, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x68, 0x61, 0x12, 0x45, 0x0a, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x3a, 0x1c, 0x6b, 0x5f,
+	0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61,
+	0x6e, 0x6b, 0x5f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x72, 0x6f,
+	0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x1a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
+	0x64, 0x12, 0x41, 0x0a, 0x1d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d,
+	0x69, 0x6e, 0x69, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x67, 0x63,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x55, 0x67, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x14, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x4b, 0x0a,
+	0x15, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x43,
+	0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22,
+	0x82, 0x01, 0x0a, 0x0d, 0x43, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x65, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x11, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x64, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x3a, 0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x42, 0x61, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x5f,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x25, 0x43,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x26, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x10, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52,
+	0x0e, 0x63, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x4d, 0x0a, 0x27, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x56,
+	0x0a, 0x28, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x13, 0x43, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d,
+	0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x74, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x12, 0x28, 0x0a, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x23,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49,
+	0x64, 0x22, 0xe1, 0x01, 0x0a, 0x24, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x40, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x43, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x4a, 0x0a, 0x24, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x22, 0x27, 0x0a, 0x25, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x21, 0x43,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x63, 0x68, 0x6f, 0x5f,
+	0x74, 0x6f, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x63, 0x68, 0x6f, 0x54, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xcb, 0x01,
+	0x0a, 0x22, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x53, 0x65, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x62, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x42, 0x62, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x25,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x41, 0x63, 0x6b, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x86, 0x01, 0x0a, 0x22, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x23, 0x43, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x69, 0x0a, 0x22, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x25, 0x0a, 0x23, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x1a, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x73, 0x74, 0x65,
+	0x61, 0x6d, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x21, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x73,
+	0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0x24, 0x0a, 0x22, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x1c, 0x43, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x42, 0x0a,
+	0x1d, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x6e,
+	0x73, 0x22, 0x40, 0x0a, 0x1a, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x1b, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x1c, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x5f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x1d,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5b, 0x0a, 0x1c, 0x43, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x5f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x1c, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x1f, 0x43, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x54,
+	0x6f, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x73, 0x74, 0x65,
+	0x61, 0x6d, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x5f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x24, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x43, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x8e, 0x03, 0x0a, 0x2a, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x08, 0x6d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x6d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x6c, 0x12, 0x35, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x6f, 0x5f, 0x62, 0x62, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x42, 0x62,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xd3, 0x01, 0x0a, 0x28, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x45,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x3a, 0x24, 0x6b, 0x5f, 0x45, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52,
+	0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x2a, 0x43, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x43, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x2f, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x63, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x3c, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43,
+	0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x15, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x43, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x62, 0x0a, 0x0b, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x3a, 0x24, 0x6b,
+	0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2a,
+	0x9c, 0x01, 0x0a, 0x12, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4a, 0x6f, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x6b, 0x5f, 0x45, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x6b, 0x5f, 0x45, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x6b, 0x5f, 0x45, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x54, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x63, 0x2a, 0xa4,
+	0x02, 0x0a, 0x12, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x5f, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x6b, 0x5f, 0x45, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x5f,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x10, 0x0a, 0x12, 0x1e, 0x0a, 0x1a, 0x6b, 0x5f, 0x45, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b,
+	0x5f, 0x47, 0x75, 0x65, 0x73, 0x74, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x6b, 0x5f, 0x45, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b,
+	0x5f, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x14, 0x12, 0x22, 0x0a, 0x1e, 0x6b, 0x5f, 0x45,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e,
+	0x6b, 0x5f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x10, 0x1e, 0x12, 0x20, 0x0a,
+	0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x61, 0x6e, 0x6b, 0x5f, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x72, 0x10, 0x28, 0x12,
+	0x1e, 0x0a, 0x1a, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x5f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x10, 0x32, 0x12,
+	0x24, 0x0a, 0x20, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x5f, 0x54, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x10, 0x63, 0x2a, 0xf4, 0x01, 0x0a, 0x1a, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x24, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x72,
+	0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12, 0x25,
+	0x0a, 0x21, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x4e,
+	0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74,
+	0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x10,
+	0x02, 0x12, 0x2b, 0x0a, 0x27, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x72, 0x6f, 0x6f, 0x6d,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x5f, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x10, 0x03, 0x12, 0x2c,
+	0x0a, 0x28, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x41,
+	0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x10, 0x04, 0x2a, 0x97, 0x03, 0x0a,
+	0x16, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x6b, 0x5f, 0x45,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73,
+	0x67, 0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
+	0x64, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x50, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x4b, 0x69, 0x63,
+	0x6b, 0x65, 0x64, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x28, 0x0a, 0x24, 0x6b, 0x5f, 0x45, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x10,
+	0x08, 0x12, 0x2f, 0x0a, 0x2b, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x54, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x10, 0x09, 0x12, 0x2e, 0x0a, 0x2a, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x10, 0x0a, 0x12, 0x22, 0x0a, 0x1e, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x5f, 0x41, 0x70, 0x70, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x10, 0x0b, 0x2a, 0xcb, 0x03, 0x0a, 0x1a, 0x45, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12,
+	0x27, 0x0a, 0x23, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x6b, 0x5f, 0x45, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x50, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10,
+	0x02, 0x12, 0x27, 0x0a, 0x23, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x4b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24, 0x6b, 0x5f,
+	0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x64, 0x10, 0x04, 0x12, 0x2c, 0x0a, 0x28, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x10, 0x07, 0x12, 0x30, 0x0a, 0x2c, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x10, 0x08, 0x12, 0x26, 0x0a, 0x22, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x10, 0x09, 0x12, 0x27, 0x0a, 0x23,
+	0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x42, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x10, 0x0a, 0x12, 0x2d, 0x0a, 0x29, 0x6b, 0x5f, 0x45, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x10, 0x0c, 0x32, 0x8c, 0x11, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x2e, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65,
+	0x74, 0x4d, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xb5, 0x18, 0x1c,
+	0x47, 0x65, 0x74, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x75,
+	0x72, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x9a, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xb5, 0x18,
+	0x28, 0x47, 0x65, 0x74, 0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20,
+	0x63, 0x68, 0x61, 0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x7a, 0x0a, 0x11, 0x4a, 0x6f, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24,
+	0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x4a, 0x6f, 0x69, 0x6e, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xb5, 0x18,
+	0x14, 0x4a, 0x6f, 0x69, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x7e, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x2e, 0x43, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xb5, 0x18, 0x15,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x82, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x26, 0x82, 0xb5, 0x18, 0x22, 0x53, 0x65, 0x6e, 0x64, 0x20, 0x61, 0x20, 0x63,
+	0x68, 0x61, 0x74, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x61,
+	0x20, 0x63, 0x68, 0x61, 0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x8f, 0x01, 0x0a, 0x0e, 0x41,
+	0x63, 0x6b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x41, 0x63, 0x6b, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0b, 0x2e, 0x4e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x48, 0x82, 0xb5, 0x18, 0x44, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x65, 0x20, 0x68, 0x61, 0x76, 0x65,
+	0x20, 0x73, 0x65, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x72,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x63, 0x68, 0x61, 0x74, 0x12, 0x82, 0x01, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x23, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x5f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xb5,
+	0x18, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x63, 0x68, 0x61, 0x74,
+	0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x20, 0x6c, 0x69, 0x6e,
+	0x6b, 0x12, 0x8e, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x43, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x82, 0xb5, 0x18, 0x2b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x73, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x63, 0x68, 0x61,
+	0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x20, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x6d, 0x0a, 0x11, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72,
+	0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1d, 0x82, 0xb5, 0x18, 0x19, 0x4b, 0x69, 0x63, 0x6b, 0x73, 0x20, 0x61, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x82, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
+	0x82, 0xb5, 0x18, 0x22, 0x42, 0x61, 0x6e, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x75, 0x6e, 0x62, 0x61,
+	0x6e, 0x73, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61,
+	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x78, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x5f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xb5, 0x18, 0x27, 0x47, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20,
+	0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x6d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x43,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x5f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xb5, 0x18, 0x22, 0x47, 0x65, 0x74,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x63, 0x68, 0x61, 0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x75, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x2e,
+	0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x43,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xb5,
+	0x18, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x67, 0x6f, 0x75, 0x70, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67, 0x69, 0x76, 0x65,
+	0x6e, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x65, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xb5, 0x18, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73,
+	0x20, 0x61, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0x65, 0x0a,
+	0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x43, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x43, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xb5, 0x18, 0x14,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x62,
+	0x79, 0x20, 0x49, 0x44, 0x12, 0x6f, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x54,
+	0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x5f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x6f, 0x55, 0x73, 0x65,
+	0x72, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xb5, 0x18, 0x15,
+	0x41, 0x64, 0x64, 0x73, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x61,
+	0x20, 0x75, 0x73, 0x65, 0x72, 0x12, 0x83, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x43,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xb5, 0x18,
+	0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20,
+	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x16, 0x82, 0xb5, 0x18,
+	0x12, 0x43, 0x68, 0x61, 0x74, 0x20, 0x52, 0x6f, 0x6f, 0x6d, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x32, 0xa1, 0x06, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x7b, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2b, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x0b, 0x2e, 0x4e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82,
+	0xb5, 0x18, 0x20, 0x4e, 0x65, 0x77, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20, 0x72,
+	0x6f, 0x6f, 0x6d, 0x12, 0x95, 0x01, 0x0a, 0x17, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x29, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0b, 0x2e, 0x4e, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xb5, 0x18, 0x3e, 0x41, 0x20, 0x63,
+	0x68, 0x61, 0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x27,
+	0x73, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x68, 0x61, 0x73, 0x20, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x20, 0x28, 0x6a, 0x6f, 0x69, 0x6e, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x2f, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x12, 0xb4, 0x01, 0x0a, 0x1f,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x3d, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x68, 0x61, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0b,
+	0x2e, 0x4e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xb5, 0x18,
+	0x41, 0x55, 0x73, 0x65, 0x72, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x28, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x2c, 0x20, 0x61, 0x63, 0x6b, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x20,
+	0x65, 0x74, 0x63, 0x29, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x2e, 0x12, 0x88, 0x01, 0x0a, 0x1f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x0b, 0x2e, 0x4e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2b, 0x82, 0xb5, 0x18, 0x27, 0x43, 0x68, 0x61, 0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x20,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x2f, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x20, 0x68, 0x61, 0x73, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x89, 0x01,
+	0x0a, 0x1e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x30, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x5f, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x0b, 0x2e, 0x4e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x82, 0xb5, 0x18, 0x24, 0x43, 0x68, 0x61, 0x74, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x27, 0x73, 0x20, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x20, 0x68, 0x61, 0x76,
+	0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x1a, 0x2c, 0x82, 0xb5, 0x18, 0x24, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x68, 0x61, 0x74, 0x20, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0xc0, 0xb5, 0x18, 0x02, 0x42, 0x03, 0x80, 0x01, 0x01,
+}
+
+var (
+	file_steammessages_chat_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_chat_steamclient_proto_rawDescData = file_steammessages_chat_steamclient_proto_rawDesc
+)
+
+func file_steammessages_chat_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_chat_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_chat_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(file_steammessages_chat_steamclient_proto_rawDescData)
+	})
+	return file_steammessages_chat_steamclient_proto_rawDescData
+}
+
+var file_steammessages_chat_steamclient_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_steammessages_chat_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_steammessages_chat_steamclient_proto_goTypes = []interface{}{
+	(EChatRoomJoinState)(0),                                              // 0: EChatRoomJoinState
+	(EChatRoomGroupRank)(0),                                              // 1: EChatRoomGroupRank
+	(EChatRoomNotificationLevel)(0),                                      // 2: EChatRoomNotificationLevel
+	(EChatRoomServerMessage)(0),                                          // 3: EChatRoomServerMessage
+	(EChatRoomMemberStateChange)(0),                                      // 4: EChatRoomMemberStateChange
+	(*CChatRoleActions)(nil),                                             // 5: CChatRoleActions
+	(*CChatRole)(nil),                                                    // 6: CChatRole
+	(*CChatRoomState)(nil),                                               // 7: CChatRoomState
+	(*CChatRoomGroupHeaderState)(nil),                                    // 8: CChatRoomGroupHeaderState
+	(*CChatRoomMember)(nil),                                              // 9: CChatRoomMember
+	(*CUserChatRoomState)(nil),                                           // 10: CUserChatRoomState
+	(*CUserChatRoomGroupState)(nil),                                      // 11: CUserChatRoomGroupState
+	(*CChatRoomGroupSummaryResponse)(nil),                                // 12: CChatRoomGroupSummaryResponse
+	(*CChatRoomSummaryPair)(nil),                                         // 13: CChatRoomSummaryPair
+	(*CChatMentions)(nil),                                                // 14: CChatMentions
+	(*ServerMessage)(nil),                                                // 15: ServerMessage
+	(*CChatRoomBan)(nil),                                                 // 16: CChatRoomBan
+	(*CChatRoom_GetMyChatRoomGroups_Request)(nil),                        // 17: CChatRoom_GetMyChatRoomGroups_Request
+	(*CChatRoom_GetMyChatRoomGroups_Response)(nil),                       // 18: CChatRoom_GetMyChatRoomGroups_Response
+	(*CChatRoom_GetChatRoomGroupState_Request)(nil),                      // 19: CChatRoom_GetChatRoomGroupState_Request
+	(*CChatRoom_GetChatRoomGroupState_Response)(nil),                     // 20: CChatRoom_GetChatRoomGroupState_Response
+	(*CChatRoomGroupState)(nil),                                          // 21: CChatRoomGroupState
+	(*CChatRoom_JoinChatRoomGroup_Request)(nil),                          // 22: CChatRoom_JoinChatRoomGroup_Request
+	(*CChatRoom_JoinChatRoomGroup_Response)(nil),                         // 23: CChatRoom_JoinChatRoomGroup_Response
+	(*CChatRoom_LeaveChatRoomGroup_Request)(nil),                         // 24: CChatRoom_LeaveChatRoomGroup_Request
+	(*CChatRoom_LeaveChatRoomGroup_Response)(nil),                        // 25: CChatRoom_LeaveChatRoomGroup_Response
+	(*CChatRoom_SendChatMessage_Request)(nil),                            // 26: CChatRoom_SendChatMessage_Request
+	(*CChatRoom_SendChatMessage_Response)(nil),                           // 27: CChatRoom_SendChatMessage_Response
+	(*CChatRoom_AckChatMessage_Notification)(nil),                        // 28: CChatRoom_AckChatMessage_Notification
+	(*CChatRoom_CreateInviteLink_Request)(nil),                           // 29: CChatRoom_CreateInviteLink_Request
+	(*CChatRoom_CreateInviteLink_Response)(nil),                          // 30: CChatRoom_CreateInviteLink_Response
+	(*CChatRoom_DeleteInviteLink_Request)(nil),                           // 31: CChatRoom_DeleteInviteLink_Request
+	(*CChatRoom_DeleteInviteLink_Response)(nil),                          // 32: CChatRoom_DeleteInviteLink_Response
+	(*CChatRoom_KickUser_Request)(nil),                                   // 33: CChatRoom_KickUser_Request
+	(*CChatRoom_KickUser_Response)(nil),                                  // 34: CChatRoom_KickUser_Response
+	(*CChatRoom_SetUserBanState_Request)(nil),                            // 35: CChatRoom_SetUserBanState_Request
+	(*CChatRoom_SetUserBanState_Response)(nil),                           // 36: CChatRoom_SetUserBanState_Response
+	(*CChatRoom_GetBanList_Request)(nil),                                 // 37: CChatRoom_GetBanList_Request
+	(*CChatRoom_GetBanList_Response)(nil),                                // 38: CChatRoom_GetBanList_Response
+	(*CChatRoom_GetRoles_Request)(nil),                                   // 39: CChatRoom_GetRoles_Request
+	(*CChatRoom_GetRoles_Response)(nil),                                  // 40: CChatRoom_GetRoles_Response
+	(*CChatRoom_CreateRole_Request)(nil),                                 // 41: CChatRoom_CreateRole_Request
+	(*CChatRoom_CreateRole_Response)(nil),                                // 42: CChatRoom_CreateRole_Response
+	(*CChatRoom_DeleteRole_Request)(nil),                                 // 43: CChatRoom_DeleteRole_Request
+	(*CChatRoom_DeleteRole_Response)(nil),                                // 44: CChatRoom_DeleteRole_Response
+	(*CChatRoom_RenameRole_Request)(nil),                                 // 45: CChatRoom_RenameRole_Request
+	(*CChatRoom_RenameRole_Response)(nil),                                // 46: CChatRoom_RenameRole_Response
+	(*CChatRoom_AddRoleToUser_Request)(nil),                              // 47: CChatRoom_AddRoleToUser_Request
+	(*CChatRoom_AddRoleToUser_Response)(nil),                             // 48: CChatRoom_AddRoleToUser_Response
+	(*CChatRoom_DeleteRoleFromUser_Request)(nil),                         // 49: CChatRoom_DeleteRoleFromUser_Request
+	(*CChatRoom_DeleteRoleFromUser_Response)(nil),                        // 50: CChatRoom_DeleteRoleFromUser_Response
+	(*CChatRoom_IncomingChatMessage_Notification)(nil),                   // 51: CChatRoom_IncomingChatMessage_Notification
+	(*CChatRoom_MemberStateChange_Notification)(nil),                     // 52: CChatRoom_MemberStateChange_Notification
+	(*CChatRoom_ChatRoomHeaderState_Notification)(nil),                   // 53: CChatRoom_ChatRoomHeaderState_Notification
+	(*CChatRoom_ChatRoomGroupRoomsChange_Notification)(nil),              // 54: CChatRoom_ChatRoomGroupRoomsChange_Notification
+	(*CChatRoomClient_NotifyChatGroupUserStateChanged_Notification)(nil), // 55: CChatRoomClient_NotifyChatGroupUserStateChanged_Notification
+	(*NoResponse)(nil),      // 56: NoResponse
+}
+var file_steammessages_chat_steamclient_proto_depIdxs = []int32{
+	6,  // 0: CChatRoomGroupHeaderState.roles:type_name -> CChatRole
+	5,  // 1: CChatRoomGroupHeaderState.role_actions:type_name -> CChatRoleActions
+	0,  // 2: CChatRoomMember.state:type_name -> EChatRoomJoinState
+	1,  // 3: CChatRoomMember.rank:type_name -> EChatRoomGroupRank
+	2,  // 4: CUserChatRoomState.desktop_notification_level:type_name -> EChatRoomNotificationLevel
+	2,  // 5: CUserChatRoomState.mobile_notification_level:type_name -> EChatRoomNotificationLevel
+	10, // 6: CUserChatRoomGroupState.user_chat_room_state:type_name -> CUserChatRoomState
+	2,  // 7: CUserChatRoomGroupState.desktop_notification_level:type_name -> EChatRoomNotificationLevel
+	2,  // 8: CUserChatRoomGroupState.mobile_notification_level:type_name -> EChatRoomNotificationLevel
+	7,  // 9: CChatRoomGroupSummaryResponse.chat_rooms:type_name -> CChatRoomState
+	1,  // 10: CChatRoomGroupSummaryResponse.rank:type_name -> EChatRoomGroupRank
+	5,  // 11: CChatRoomGroupSummaryResponse.role_actions:type_name -> CChatRoleActions
+	11, // 12: CChatRoomSummaryPair.user_chat_group_state:type_name -> CUserChatRoomGroupState
+	12, // 13: CChatRoomSummaryPair.group_summary:type_name -> CChatRoomGroupSummaryResponse
+	3,  // 14: ServerMessage.message:type_name -> EChatRoomServerMessage
+	13, // 15: CChatRoom_GetMyChatRoomGroups_Response.chat_room_groups:type_name -> CChatRoomSummaryPair
+	21, // 16: CChatRoom_GetChatRoomGroupState_Response.state:type_name -> CChatRoomGroupState
+	8,  // 17: CChatRoomGroupState.header_state:type_name -> CChatRoomGroupHeaderState
+	9,  // 18: CChatRoomGroupState.members:type_name -> CChatRoomMember
+	7,  // 19: CChatRoomGroupState.chat_rooms:type_name -> CChatRoomState
+	9,  // 20: CChatRoomGroupState.kicked:type_name -> CChatRoomMember
+	12, // 21: CChatRoom_JoinChatRoomGroup_Response.state:type_name -> CChatRoomGroupSummaryResponse
+	11, // 22: CChatRoom_JoinChatRoomGroup_Response.user_chat_state:type_name -> CUserChatRoomGroupState
+	16, // 23: CChatRoom_GetBanList_Response.bans:type_name -> CChatRoomBan
+	6,  // 24: CChatRoom_GetRoles_Response.roles:type_name -> CChatRole
+	5,  // 25: CChatRoom_CreateRole_Response.actions:type_name -> CChatRoleActions
+	14, // 26: CChatRoom_IncomingChatMessage_Notification.mentions:type_name -> CChatMentions
+	15, // 27: CChatRoom_IncomingChatMessage_Notification.server_message:type_name -> ServerMessage
+	9,  // 28: CChatRoom_MemberStateChange_Notification.member:type_name -> CChatRoomMember
+	4,  // 29: CChatRoom_MemberStateChange_Notification.change:type_name -> EChatRoomMemberStateChange
+	8,  // 30: CChatRoom_ChatRoomHeaderState_Notification.header_state:type_name -> CChatRoomGroupHeaderState
+	7,  // 31: CChatRoom_ChatRoomGroupRoomsChange_Notification.chat_rooms:type_name -> CChatRoomState
+	11, // 32: CChatRoomClient_NotifyChatGroupUserStateChanged_Notification.user_chat_group_state:type_name -> CUserChatRoomGroupState
+	12, // 33: CChatRoomClient_NotifyChatGroupUserStateChanged_Notification.group_summary:type_name -> CChatRoomGroupSummaryResponse
+	4,  // 34: CChatRoomClient_NotifyChatGroupUserStateChanged_Notification.user_action:type_name -> EChatRoomMemberStateChange
+	17, // 35: ChatRoom.GetMyChatRoomGroups:input_type -> CChatRoom_GetMyChatRoomGroups_Request
+	19, // 36: ChatRoom.GetChatRoomGroupState:input_type -> CChatRoom_GetChatRoomGroupState_Request
+	22, // 37: ChatRoom.JoinChatRoomGroup:input_type -> CChatRoom_JoinChatRoomGroup_Request
+	24, // 38: ChatRoom.LeaveChatRoomGroup:input_type -> CChatRoom_LeaveChatRoomGroup_Request
+	26, // 39: ChatRoom.SendChatMessage:input_type -> CChatRoom_SendChatMessage_Request
+	28, // 40: ChatRoom.AckChatMessage:input_type -> CChatRoom_AckChatMessage_Notification
+	29, // 41: ChatRoom.CreateInviteLink:input_type -> CChatRoom_CreateInviteLink_Request
+	31, // 42: ChatRoom.DeleteInviteLink:input_type -> CChatRoom_DeleteInviteLink_Request
+	33, // 43: ChatRoom.KickUserFromGroup:input_type -> CChatRoom_KickUser_Request
+	35, // 44: ChatRoom.SetUserBanState:input_type -> CChatRoom_SetUserBanState_Request
+	37, // 45: ChatRoom.GetBanList:input_type -> CChatRoom_GetBanList_Request
+	39, // 46: ChatRoom.GetRoles:input_type -> CChatRoom_GetRoles_Request
+	41, // 47: ChatRoom.CreateRole:input_type -> CChatRoom_CreateRole_Request
+	43, // 48: ChatRoom.DeleteRole:input_type -> CChatRoom_DeleteRole_Request
+	45, // 49: ChatRoom.RenameRole:input_type -> CChatRoom_RenameRole_Request
+	47, // 50: ChatRoom.AddRoleToUser:input_type -> CChatRoom_AddRoleToUser_Request
+	49, // 51: ChatRoom.DeleteRoleFromUser:input_type -> CChatRoom_DeleteRoleFromUser_Request
+	51, // 52: ChatRoomClient.NotifyIncomingChatMessage:input_type -> CChatRoom_IncomingChatMessage_Notification
+	52, // 53: ChatRoomClient.NotifyMemberStateChange:input_type -> CChatRoom_MemberStateChange_Notification
+	55, // 54: ChatRoomClient.NotifyChatGroupUserStateChanged:input_type -> CChatRoomClient_NotifyChatGroupUserStateChanged_Notification
+	53, // 55: ChatRoomClient.NotifyChatRoomHeaderStateChange:input_type -> CChatRoom_ChatRoomHeaderState_Notification
+	54, // 56: ChatRoomClient.NotifyChatRoomGroupRoomsChange:input_type -> CChatRoom_ChatRoomGroupRoomsChange_Notification
+	18, // 57: ChatRoom.GetMyChatRoomGroups:output_type -> CChatRoom_GetMyChatRoomGroups_Response
+	20, // 58: ChatRoom.GetChatRoomGroupState:output_type -> CChatRoom_GetChatRoomGroupState_Response
+	23, // 59: ChatRoom.JoinChatRoomGroup:output_type -> CChatRoom_JoinChatRoomGroup_Response
+	25, // 60: ChatRoom.LeaveChatRoomGroup:output_type -> CChatRoom_LeaveChatRoomGroup_Response
+	27, // 61: ChatRoom.SendChatMessage:output_type -> CChatRoom_SendChatMessage_Response
+	56, // 62: ChatRoom.AckChatMessage:output_type -> NoResponse
+	30, // 63: ChatRoom.CreateInviteLink:output_type -> CChatRoom_CreateInviteLink_Response
+	32, // 64: ChatRoom.DeleteInviteLink:output_type -> CChatRoom_DeleteInviteLink_Response
+	34, // 65: ChatRoom.KickUserFromGroup:output_type -> CChatRoom_KickUser_Response
+	36, // 66: ChatRoom.SetUserBanState:output_type -> CChatRoom_SetUserBanState_Response
+	38, // 67: ChatRoom.GetBanList:output_type -> CChatRoom_GetBanList_Response
+	40, // 68: ChatRoom.GetRoles:output_type -> CChatRoom_GetRoles_Response
+	42, // 69: ChatRoom.CreateRole:output_type -> CChatRoom_CreateRole_Response
+	44, // 70: ChatRoom.DeleteRole:output_type -> CChatRoom_DeleteRole_Response
+	46, // 71: ChatRoom.RenameRole:output_type -> CChatRoom_RenameRole_Response
+	48, // 72: ChatRoom.AddRoleToUser:output_type -> CChatRoom_AddRoleToUser_Response
+	50, // 73: ChatRoom.DeleteRoleFromUser:output_type -> CChatRoom_DeleteRoleFromUser_Response
+	56, // 74: ChatRoomClient.NotifyIncomingChatMessage:output_type -> NoResponse
+	56, // 75: ChatRoomClient.NotifyMemberStateChange:output_type -> NoResponse
+	56, // 76: ChatRoomClient.NotifyChatGroupUserStateChanged:output_type -> NoResponse
+	56, // 77: ChatRoomClient.NotifyChatRoomHeaderStateChange:output_type -> NoResponse
+	56, // 78: ChatRoomClient.NotifyChatRoomGroupRoomsChange:output_type -> NoResponse
+	57, // [57:79] is the sub-list for method output_type
+	35, // [35:57] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_chat_steamclient_proto_init() }
+func file_steammessages_chat_steamclient_proto_init() {
+	if File_steammessages_chat_steamclient_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_steammessages_chat_steamclient_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoleActions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomGroupHeaderState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CUserChatRoomState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CUserChatRoomGroupState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomGroupSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomSummaryPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatMentions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomBan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetMyChatRoomGroups_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetMyChatRoomGroups_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetChatRoomGroupState_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetChatRoomGroupState_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomGroupState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_JoinChatRoomGroup_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_JoinChatRoomGroup_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_LeaveChatRoomGroup_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_LeaveChatRoomGroup_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_SendChatMessage_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_SendChatMessage_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_AckChatMessage_Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_CreateInviteLink_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_CreateInviteLink_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_DeleteInviteLink_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_DeleteInviteLink_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_KickUser_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_KickUser_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_SetUserBanState_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_SetUserBanState_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetBanList_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetBanList_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetRoles_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_GetRoles_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_CreateRole_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_CreateRole_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_DeleteRole_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_DeleteRole_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_RenameRole_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_RenameRole_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_AddRoleToUser_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_AddRoleToUser_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_DeleteRoleFromUser_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_DeleteRoleFromUser_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_IncomingChatMessage_Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_MemberStateChange_Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_ChatRoomHeaderState_Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoom_ChatRoomGroupRoomsChange_Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_chat_steamclient_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChatRoomClient_NotifyChatGroupUserStateChanged_Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_steammessages_chat_steamclient_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   51,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_steammessages_chat_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_chat_steamclient_proto_depIdxs,
+		EnumInfos:         file_steammessages_chat_steamclient_proto_enumTypes,
+		MessageInfos:      file_steammessages_chat_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_chat_steamclient_proto = out.File
+	file_steammessages_chat_steamclient_proto_rawDesc = nil
+	file_steammessages_chat_steamclient_proto_goTypes = nil
+	file_steammessages_chat_steamclient_proto_depIdxs = nil
+}
@@ -0,0 +1,107 @@
+package steam
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/vuquang23/go-steam/keyvalues"
+	"github.com/vuquang23/go-steam/protocol"
+	"github.com/vuquang23/go-steam/protocol/protobuf"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/steamid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Provides access to rich presence, the status that games show to friends,
+// like "Processing 3 trades".
+type RichPresence struct {
+	client *Client
+}
+
+func newRichPresence(client *Client) *RichPresence {
+	return &RichPresence{client: client}
+}
+
+// Sets our rich presence in the first game we're in, see
+// GameCoordinator.SetGamesPlayed. An empty map clears it.
+//
+// Most games only show keys that their localization knows about, "status"
+// is shown by the Steam client for any game.
+func (r *RichPresence) Set(values map[string]string) error {
+	games := r.client.GC.GamesPlayed()
+	if len(games) == 0 {
+		return errors.New("rich presence: not in a game, call GC.SetGamesPlayed first")
+	}
+	r.SetForApp(uint32(games[0]&0xFFFFFF), values)
+	return nil
+}
+
+// Sets our rich presence in the given app. An empty map clears it.
+func (r *RichPresence) SetForApp(appId uint32, values map[string]string) {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientRichPresenceUpload, &protobuf.CMsgClientRichPresenceUpload{
+		RichPresenceKv: writeRichPresenceKV(values),
+	})
+	msg.Header.Proto.RoutingAppid = proto.Uint32(appId)
+	r.client.Write(msg)
+}
+
+// Requests the rich presence of the given users in an app. A
+// RichPresenceEvent is fired for each user that is in the app and the
+// rich presence of friends is updated in Social.Friends.
+func (r *RichPresence) Request(appId uint32, ids ...steamid.SteamId) {
+	requests := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		requests = append(requests, id.ToUint64())
+	}
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientRichPresenceRequest, &protobuf.CMsgClientRichPresenceRequest{
+		SteamidRequest: requests,
+	})
+	msg.Header.Proto.RoutingAppid = proto.Uint32(appId)
+	r.client.Write(msg)
+}
+
+func (r *RichPresence) HandlePacket(packet *protocol.Packet) {
+	switch packet.EMsg {
+	case steamlang.EMsg_ClientRichPresenceInfo:
+		r.handleRichPresenceInfo(packet)
+	}
+}
+
+func (r *RichPresence) handleRichPresenceInfo(packet *protocol.Packet) {
+	body := new(protobuf.CMsgClientRichPresenceInfo)
+	msg := packet.ReadProtoMsg(body)
+	appId := msg.Header.Proto.GetRoutingAppid()
+	for _, presence := range body.GetRichPresence() {
+		values, err := readRichPresenceKV(presence.GetRichPresenceKv())
+		if err != nil {
+			r.client.Errorf("rich presence: failed to read values of %v: %v", presence.GetSteamidUser(), err)
+			continue
+		}
+		id := steamid.SteamId(presence.GetSteamidUser())
+		r.client.Social.Friends.SetRichPresence(id, values)
+		r.client.Emit(&RichPresenceEvent{
+			SteamId: id,
+			AppId:   appId,
+			Values:  values,
+		})
+	}
+}
+
+// Writes the values as a binary KeyValues section named "RP"
+func writeRichPresenceKV(values map[string]string) []byte {
+	buf := new(bytes.Buffer)
+	keyvalues.NewStringSection("RP", values).WriteBinary(buf)
+	return buf.Bytes()
+}
+
+// Reads the values of a binary KeyValues section, numbers are formatted as strings
+func readRichPresenceKV(data []byte) (map[string]string, error) {
+	if len(data) == 0 {
+		return make(map[string]string), nil
+	}
+	kv, err := keyvalues.ReadBinary(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return kv.StringMap(), nil
+}
@@ -0,0 +1,12 @@
+package steam
+
+import (
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Fired in response to RichPresence.Request
+type RichPresenceEvent struct {
+	SteamId steamid.SteamId `json:",string"`
+	AppId   uint32
+	Values  map[string]string
+}
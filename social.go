@@ -4,15 +4,21 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	"github.com/vuquang23/go-steam/protocol"
 	"github.com/vuquang23/go-steam/protocol/protobuf"
+	"github.com/vuquang23/go-steam/protocol/protobuf/unified"
 	"github.com/vuquang23/go-steam/protocol/steamlang"
 	"github.com/vuquang23/go-steam/rwu"
 	"github.com/vuquang23/go-steam/socialcache"
 	"github.com/vuquang23/go-steam/steamid"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -26,17 +32,113 @@ type Social struct {
 
 	Friends *socialcache.FriendsList
 	Groups  *socialcache.GroupsList
-	Chats   *socialcache.ChatsList
+	// The groups (tags) we sorted our friends into
+	FriendGroups *socialcache.FriendGroupsList
+	// Steam levels and name histories, see GetSteamLevels and GetPersonaNameHistory
+	Profiles *socialcache.ProfilesList
+	// Officers of clans, see GetClanOfficers
+	ClanOfficers *socialcache.ClanOfficersList
+	// Deprecated: only tracks the legacy clan chats, see ChatRoom.Groups.
+	Chats *socialcache.ChatsList
+
+	store socialcache.Store
+	// pending save of the cache, see saveCache
+	saveTimer *time.Timer
+	// GetClanOfficers requests that wait for the officers by clan
+	officerLookups map[steamid.SteamId]*officerLookup
 
 	client *Client
 }
 
 func newSocial(client *Client) *Social {
 	return &Social{
-		Friends: socialcache.NewFriendsList(),
-		Groups:  socialcache.NewGroupsList(),
-		Chats:   socialcache.NewChatsList(),
-		client:  client,
+		Friends:      socialcache.NewFriendsList(),
+		Groups:       socialcache.NewGroupsList(),
+		FriendGroups: socialcache.NewFriendGroupsList(),
+		Profiles:     socialcache.NewProfilesList(),
+		ClanOfficers: socialcache.NewClanOfficersList(),
+		Chats:        socialcache.NewChatsList(),
+		client:       client,
+	}
+}
+
+// Sets the store that the friends and groups are loaded from after logging
+// on and saved to a few seconds after they changed. It's not used if nil,
+// which is the default.
+func (s *Social) SetCacheStore(store socialcache.Store) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.store = store
+}
+
+// Returns the current friends and groups of the logged on account
+func (s *Social) Snapshot() *socialcache.Snapshot {
+	return &socialcache.Snapshot{
+		SteamId:      s.client.SteamId(),
+		Time:         time.Now(),
+		Friends:      s.Friends.Snapshot(),
+		Groups:       s.Groups.Snapshot(),
+		FriendGroups: s.FriendGroups.Snapshot(),
+		Chats:        s.Chats.Snapshot(),
+	}
+}
+
+// Replaces the friends and groups with the ones of a snapshot. No
+// listeners are called, so friends of the snapshot aren't reported as new
+// when the friends list is received.
+func (s *Social) Restore(snapshot *socialcache.Snapshot) {
+	s.Friends.Restore(snapshot.Friends)
+	s.Groups.Restore(snapshot.Groups)
+	s.FriendGroups.Restore(snapshot.FriendGroups)
+	s.Chats.Restore(snapshot.Chats)
+}
+
+func (s *Social) loadCache() {
+	s.mutex.RLock()
+	store := s.store
+	s.mutex.RUnlock()
+	if store == nil {
+		return
+	}
+	snapshot, err := store.Load(s.client.SteamId())
+	if err != nil {
+		s.client.Errorf("socialcache: failed to load snapshot: %v", err)
+		return
+	}
+	if snapshot != nil {
+		s.Restore(snapshot)
+	}
+}
+
+// Changes are saved at most this often, so that receiving the persona
+// states of many friends after logging on doesn't write the store every time
+const cacheSaveDelay = 5 * time.Second
+
+// Schedules a save of the cache, changes until then are saved along
+func (s *Social) saveCache() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.store == nil || s.saveTimer != nil {
+		return
+	}
+	s.saveTimer = time.AfterFunc(cacheSaveDelay, s.SaveCache)
+}
+
+// Saves the cache to the store right away instead of waiting for pending
+// changes to be saved. Call it before exiting to not lose the latest changes.
+func (s *Social) SaveCache() {
+	s.mutex.Lock()
+	store := s.store
+	if s.saveTimer != nil {
+		s.saveTimer.Stop()
+		s.saveTimer = nil
+	}
+	s.mutex.Unlock()
+	if store == nil || s.client.SteamId() == 0 {
+		return
+	}
+	if err := store.Save(s.Snapshot()); err != nil {
+		s.client.Errorf("socialcache: failed to save snapshot: %v", err)
 	}
 }
 
@@ -82,7 +184,8 @@ func (s *Social) SetPersonaState(state steamlang.EPersonaState) {
 	}))
 }
 
-// Sends a chat message to ether a room or friend
+// Sends a chat message to ether a room or friend. Friends that use the new
+// Steam chat receive messages more reliably through SendFriendMessage.
 func (s *Social) SendMessage(to steamid.SteamId, entryType steamlang.EChatEntryType, message string) {
 	// Friend
 	if to.GetAccountType() == int32(steamlang.EAccountType_Individual) || to.GetAccountType() == int32(steamlang.EAccountType_ConsoleUser) {
@@ -147,6 +250,367 @@ func (s *Social) RequestFriendInfo(id steamid.SteamId, requestedInfo steamlang.E
 	s.RequestFriendListInfo([]steamid.SteamId{id}, requestedInfo)
 }
 
+// Creates a friend group with the given friends in it. A FriendGroupCreatedEvent
+// with the returned job id is fired in response.
+func (s *Social) CreateFriendGroup(name string, members []steamid.SteamId) protocol.JobId {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_AMClientCreateFriendsGroup, &protobuf.CMsgClientCreateFriendsGroup{
+		Steamid:        proto.Uint64(s.client.SteamId().ToUint64()),
+		Groupname:      proto.String(name),
+		SteamidFriends: steamIdsToUint64(members),
+	})
+	members = append([]steamid.SteamId(nil), members...)
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientCreateFriendsGroupResponse)
+		packet.ReadProtoMsg(body)
+		result := steamlang.EResult(body.GetEresult())
+		if result == steamlang.EResult_OK {
+			s.FriendGroups.Add(body.GetGroupid(), name)
+			for _, member := range members {
+				s.FriendGroups.AddMember(body.GetGroupid(), member)
+			}
+			s.saveCache()
+		}
+		s.client.Emit(&FriendGroupCreatedEvent{
+			JobId:   packet.TargetJobId,
+			Result:  result,
+			GroupId: body.GetGroupid(),
+			Name:    name,
+		})
+	})
+}
+
+// Renames a friend group. A FriendGroupResultEvent with the returned job id
+// is fired in response.
+func (s *Social) RenameFriendGroup(id int32, name string) protocol.JobId {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_AMClientManageFriendsGroup, &protobuf.CMsgClientManageFriendsGroup{
+		Groupid:   proto.Int32(id),
+		Groupname: proto.String(name),
+	})
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientManageFriendsGroupResponse)
+		packet.ReadProtoMsg(body)
+		s.friendGroupResult(packet, id, body.GetEresult(), func() {
+			s.FriendGroups.SetName(id, name)
+		})
+	})
+}
+
+// Deletes a friend group, the friends in it stay our friends. A
+// FriendGroupResultEvent with the returned job id is fired in response.
+func (s *Social) DeleteFriendGroup(id int32) protocol.JobId {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_AMClientDeleteFriendsGroup, &protobuf.CMsgClientDeleteFriendsGroup{
+		Steamid: proto.Uint64(s.client.SteamId().ToUint64()),
+		Groupid: proto.Int32(id),
+	})
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientDeleteFriendsGroupResponse)
+		packet.ReadProtoMsg(body)
+		s.friendGroupResult(packet, id, body.GetEresult(), func() {
+			s.FriendGroups.Remove(id)
+		})
+	})
+}
+
+// Adds a friend to a friend group. A FriendGroupResultEvent with the
+// returned job id is fired in response.
+func (s *Social) AddFriendToGroup(id int32, friend steamid.SteamId) protocol.JobId {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_AMClientAddFriendToGroup, &protobuf.CMsgClientAddFriendToGroup{
+		Groupid:     proto.Int32(id),
+		Steamiduser: proto.Uint64(friend.ToUint64()),
+	})
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientAddFriendToGroupResponse)
+		packet.ReadProtoMsg(body)
+		s.friendGroupResult(packet, id, body.GetEresult(), func() {
+			s.FriendGroups.AddMember(id, friend)
+		})
+	})
+}
+
+// Removes a friend from a friend group. A FriendGroupResultEvent with the
+// returned job id is fired in response.
+func (s *Social) RemoveFriendFromGroup(id int32, friend steamid.SteamId) protocol.JobId {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_AMClientRemoveFriendFromGroup, &protobuf.CMsgClientRemoveFriendFromGroup{
+		Groupid:     proto.Int32(id),
+		Steamiduser: proto.Uint64(friend.ToUint64()),
+	})
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientRemoveFriendFromGroupResponse)
+		packet.ReadProtoMsg(body)
+		s.friendGroupResult(packet, id, body.GetEresult(), func() {
+			s.FriendGroups.RemoveMember(id, friend)
+		})
+	})
+}
+
+// Updates the cache if the request succeeded and fires a FriendGroupResultEvent
+func (s *Social) friendGroupResult(packet *protocol.Packet, id int32, eresult uint32, update func()) {
+	result := steamlang.EResult(eresult)
+	if result == steamlang.EResult_OK {
+		update()
+		s.saveCache()
+	}
+	s.client.Emit(&FriendGroupResultEvent{
+		JobId:   packet.TargetJobId,
+		Result:  result,
+		GroupId: id,
+	})
+}
+
+// Sets the nickname of a friend, which only we can see. An empty nickname
+// removes it. A NicknameSetEvent with the returned job id is fired in response.
+func (s *Social) SetNickname(friend steamid.SteamId, nickname string) protocol.JobId {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_AMClientSetPlayerNickname, &protobuf.CMsgClientSetPlayerNickname{
+		Steamid:  proto.Uint64(friend.ToUint64()),
+		Nickname: proto.String(nickname),
+	})
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientSetPlayerNicknameResponse)
+		packet.ReadProtoMsg(body)
+		result := steamlang.EResult(body.GetEresult())
+		if result == steamlang.EResult_OK {
+			s.Friends.SetNickname(friend, nickname)
+			s.saveCache()
+		}
+		s.client.Emit(&NicknameSetEvent{
+			JobId:    packet.TargetJobId,
+			Result:   result,
+			SteamId:  friend,
+			Nickname: nickname,
+		})
+	})
+}
+
+func steamIdsToUint64(ids []steamid.SteamId) []uint64 {
+	out := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.ToUint64())
+	}
+	return out
+}
+
+// Requests the Steam levels of users, who don't have to be our friends. A
+// SteamLevelsEvent with the returned job id is fired in response and the
+// levels are stored in Profiles.
+func (s *Social) GetSteamLevels(ids []steamid.SteamId) protocol.JobId {
+	accountIds := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		accountIds = append(accountIds, id.GetAccountId())
+	}
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientFSGetFriendsSteamLevels, &protobuf.CMsgClientFSGetFriendsSteamLevels{
+		Accountids: accountIds,
+	})
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientFSGetFriendsSteamLevelsResponse)
+		packet.ReadProtoMsg(body)
+		levels := make(map[steamid.SteamId]uint32)
+		for _, friend := range body.GetFriends() {
+			id := s.client.accountSteamId(friend.GetAccountid(), steamlang.EAccountType_Individual)
+			levels[id] = friend.GetLevel()
+			s.Profiles.SetLevel(id, friend.GetLevel())
+		}
+		s.client.Emit(&SteamLevelsEvent{
+			JobId:  packet.TargetJobId,
+			Levels: levels,
+		})
+	})
+}
+
+// Requests the previous names of users. A PersonaNameHistoryEvent with the
+// returned job id is fired in response and the names are stored in Profiles.
+func (s *Social) GetPersonaNameHistory(ids []steamid.SteamId) protocol.JobId {
+	instances := make([]*protobuf.CMsgClientAMGetPersonaNameHistory_IdInstance, 0, len(ids))
+	for _, id := range ids {
+		instances = append(instances, &protobuf.CMsgClientAMGetPersonaNameHistory_IdInstance{
+			Steamid: proto.Uint64(id.ToUint64()),
+		})
+	}
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientAMGetPersonaNameHistory, &protobuf.CMsgClientAMGetPersonaNameHistory{
+		IdCount: proto.Int32(int32(len(instances))),
+		Ids:     instances,
+	})
+	return s.client.writeJob(msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientAMGetPersonaNameHistoryResponse)
+		packet.ReadProtoMsg(body)
+		histories := make([]PersonaNameHistory, 0, len(body.GetResponses()))
+		for _, response := range body.GetResponses() {
+			history := PersonaNameHistory{
+				SteamId: steamid.SteamId(response.GetSteamid()),
+				Result:  steamlang.EResult(response.GetEresult()),
+			}
+			for _, name := range response.GetNames() {
+				history.Names = append(history.Names, socialcache.PersonaName{
+					Name:  name.GetName(),
+					Since: unixTime(name.GetNameSince()),
+				})
+			}
+			if history.Result == steamlang.EResult_OK {
+				s.Profiles.SetNameHistory(history.SteamId, history.Names)
+			}
+			histories = append(histories, history)
+		}
+		s.client.Emit(&PersonaNameHistoryEvent{
+			JobId:     packet.TargetJobId,
+			Histories: histories,
+		})
+	})
+}
+
+// How long GetClanOfficers waits for the response and the officers
+const officerLookupTimeout = 10 * time.Second
+
+// A GetClanOfficers request that collects the officers of a clan
+type officerLookup struct {
+	jobIds   []protocol.JobId
+	result   steamlang.EResult
+	count    int // -1 until the response arrived
+	officers map[steamid.SteamId]socialcache.ClanOfficer
+}
+
+// Requests the officers of a clan. Steam responds with their number and
+// then sends the officers as persona states with their rank. Once all of
+// them arrived, they are stored in ClanOfficers and a ClanOfficersEvent
+// with the returned job id is fired.
+//
+// A request for a clan that is still being looked up waits for the pending one.
+func (s *Social) GetClanOfficers(clan steamid.SteamId) protocol.JobId {
+	clan = clan.ChatToClan()
+	jobId := s.client.GetNextJobId()
+	s.mutex.Lock()
+	if lookup, ok := s.officerLookups[clan]; ok {
+		lookup.jobIds = append(lookup.jobIds, jobId)
+		s.mutex.Unlock()
+		return jobId
+	}
+	if s.officerLookups == nil {
+		s.officerLookups = make(map[steamid.SteamId]*officerLookup)
+	}
+	lookup := &officerLookup{
+		jobIds:   []protocol.JobId{jobId},
+		count:    -1,
+		officers: make(map[steamid.SteamId]socialcache.ClanOfficer),
+	}
+	s.officerLookups[clan] = lookup
+	s.mutex.Unlock()
+
+	// fires the events with what arrived if Steam doesn't answer in time
+	time.AfterFunc(officerLookupTimeout, func() {
+		s.completeOfficerLookup(clan, lookup, true)
+	})
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientAMGetClanOfficers, &protobuf.CMsgClientAMGetClanOfficers{
+		SteamidClan: proto.Uint64(clan.ToUint64()),
+	})
+	s.client.writeJobWithId(jobId, msg, func(packet *protocol.Packet) {
+		body := new(protobuf.CMsgClientAMGetClanOfficersResponse)
+		packet.ReadProtoMsg(body)
+		s.mutex.Lock()
+		lookup.result = steamlang.EResult(body.GetEresult())
+		lookup.count = int(body.GetOfficerCount())
+		if lookup.result != steamlang.EResult_OK {
+			lookup.count = 0
+		}
+		s.mutex.Unlock()
+		s.completeOfficerLookup(clan, lookup, false)
+	})
+	return jobId
+}
+
+// Stores the officers and fires the events once all officers arrived, or
+// with the ones that did if force is set. Lookups without a response fail
+// with EResult_Timeout then.
+func (s *Social) completeOfficerLookup(clan steamid.SteamId, lookup *officerLookup, force bool) {
+	s.mutex.Lock()
+	if s.officerLookups[clan] != lookup {
+		s.mutex.Unlock()
+		return
+	}
+	if !force && (lookup.count < 0 || len(lookup.officers) < lookup.count) {
+		s.mutex.Unlock()
+		return
+	}
+	delete(s.officerLookups, clan)
+	if lookup.count < 0 {
+		lookup.result = steamlang.EResult_Timeout
+		lookup.count = 0
+	}
+	s.mutex.Unlock()
+	s.emitOfficers(clan, lookup)
+}
+
+// Fails all pending lookups with EResult_NoConnection, as their responses
+// won't arrive after a disconnect
+func (s *Social) cancelOfficerLookups() {
+	s.mutex.Lock()
+	lookups := s.officerLookups
+	s.officerLookups = nil
+	s.mutex.Unlock()
+	for clan, lookup := range lookups {
+		lookup.result = steamlang.EResult_NoConnection
+		lookup.count = 0
+		s.emitOfficers(clan, lookup)
+	}
+}
+
+func (s *Social) emitOfficers(clan steamid.SteamId, lookup *officerLookup) {
+	var officers []socialcache.ClanOfficer
+	if lookup.result == steamlang.EResult_OK {
+		for _, officer := range lookup.officers {
+			officers = append(officers, officer)
+		}
+		s.ClanOfficers.Set(clan, officers)
+		officers = s.ClanOfficers.ByClan(clan)
+	}
+	for _, jobId := range lookup.jobIds {
+		s.client.Emit(&ClanOfficersEvent{
+			JobId:        jobId,
+			Result:       lookup.result,
+			ClanId:       clan,
+			OfficerCount: lookup.count,
+			Officers:     officers,
+		})
+	}
+}
+
+// Invites a friend into the game we're playing. The connect string is
+// passed to the game when the friend accepts, for Source games like TF2
+// it's usually made with ConnectString.
+func (s *Social) InviteToGame(friend steamid.SteamId, connectString string) {
+	s.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientInviteToGame, &protobuf.CMsgClientInviteToGame{
+		SteamIdDest:   proto.Uint64(friend.ToUint64()),
+		SteamIdSrc:    proto.Uint64(s.client.SteamId().ToUint64()),
+		ConnectString: proto.String(connectString),
+	}))
+}
+
+// Returns the connect string that makes Source games join a server, like
+// "+connect 1.2.3.4:27015". The password is left out if empty, otherwise it's
+// quoted. The console doesn't know escapes, so quotes, semicolons and control
+// characters are removed from the password.
+func ConnectString(address string, password string) string {
+	connect := "+connect " + address
+	password = strings.Map(func(r rune) rune {
+		if r == '"' || r == ';' || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, password)
+	if password != "" {
+		connect += ` +password "` + password + `"`
+	}
+	return connect
+}
+
+// Invites a friend into a lobby of the given app
+func (s *Social) InviteToLobby(appId uint32, lobby steamid.SteamId, friend steamid.SteamId) {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientMMSInviteToLobby, &protobuf.CMsgClientMMSInviteToLobby{
+		AppId:              proto.Uint32(appId),
+		SteamIdLobby:       proto.Uint64(lobby.ToUint64()),
+		SteamIdUserInvited: proto.Uint64(friend.ToUint64()),
+	})
+	msg.Header.Proto.RoutingAppid = proto.Uint32(appId)
+	s.client.Write(msg)
+}
+
 // Requests profile information for a specified SteamId
 func (s *Social) RequestProfileInfo(id steamid.SteamId) {
 	s.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientFriendProfileInfo, &protobuf.CMsgClientFriendProfileInfo{
@@ -155,11 +619,146 @@ func (s *Social) RequestProfileInfo(id steamid.SteamId) {
 }
 
 // Requests all offline messages and marks them as read
+//
+// Deprecated: Steam only keeps a few offline messages for the legacy chat.
+// Use GetActiveMessageSessions and GetRecentMessages instead.
 func (s *Social) RequestOfflineMessages() {
 	s.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientChatGetFriendMessageHistoryForOfflineMessages, &protobuf.CMsgClientChatGetFriendMessageHistoryForOfflineMessages{}))
 }
 
+// Sends a message to a friend through the FriendMessages service, which
+// supports BBCode and echoes the message to our other sessions. A
+// FriendMessageSentEvent with the returned job id is fired in response.
+func (s *Social) SendFriendMessage(to steamid.SteamId, message string, bbcode bool) protocol.JobId {
+	return s.client.callServiceMethod("FriendMessages.SendMessage#1", &unified.CFriendMessages_SendMessage_Request{
+		Steamid:        proto.Uint64(to.ToUint64()),
+		ChatEntryType:  proto.Int32(int32(steamlang.EChatEntryType_ChatMsg)),
+		Message:        proto.String(message),
+		ContainsBbcode: proto.Bool(bbcode),
+	}, func(packet *protocol.Packet) {
+		body := new(unified.CFriendMessages_SendMessage_Response)
+		msg := packet.ReadProtoMsg(body)
+		s.client.Emit(&FriendMessageSentEvent{
+			JobId:           packet.TargetJobId,
+			Result:          serviceMethodResult(msg),
+			FriendId:        to,
+			Message:         body.GetModifiedMessage(),
+			MessageNoBBCode: body.GetMessageWithoutBbCode(),
+			Timestamp:       time.Unix(int64(body.GetServerTimestamp()), 0),
+			Ordinal:         body.GetOrdinal(),
+		})
+	})
+}
+
+// Tells a friend that we are typing a message
+func (s *Social) SendFriendTyping(to steamid.SteamId) {
+	s.client.callServiceMethod("FriendMessages.SendMessage#1", &unified.CFriendMessages_SendMessage_Request{
+		Steamid:       proto.Uint64(to.ToUint64()),
+		ChatEntryType: proto.Int32(int32(steamlang.EChatEntryType_Typing)),
+	}, nil)
+}
+
+// Marks the messages of a friend up to the given time as read
+func (s *Social) AckFriendMessage(friend steamid.SteamId, timestamp time.Time) {
+	s.client.notifyServiceMethod("FriendMessages.AckMessage#1", &unified.CFriendMessages_AckMessage_Notification{
+		SteamidPartner: proto.Uint64(friend.ToUint64()),
+		Timestamp:      proto.Uint32(uint32(timestamp.Unix())),
+	})
+}
+
+type RecentMessagesOptions struct {
+	// Caps the number of messages, zero uses Steam's default
+	Count uint32
+	// Returns only messages at or after this time. If zero, only the messages
+	// of a recent period are returned.
+	StartTime time.Time
+	// Skips the messages of StartTime's second up to this ordinal
+	StartOrdinal uint32
+	// Returns only messages before this time and ordinal. Set them to the
+	// oldest message of a response to get the page before it.
+	EndTime    time.Time
+	EndOrdinal uint32
+	// Returns only the most recent conversation, a period of about five minutes
+	MostRecentConversation bool
+	// Keeps the BBCode of the messages
+	BBCode bool
+}
+
+// Requests the chat history with a friend. options may be nil. A
+// FriendMessageHistoryEvent with the returned job id is fired in response.
+func (s *Social) GetRecentMessages(friend steamid.SteamId, options *RecentMessagesOptions) protocol.JobId {
+	if options == nil {
+		options = new(RecentMessagesOptions)
+	}
+	req := &unified.CFriendMessages_GetRecentMessages_Request{
+		Steamid1:               proto.Uint64(s.client.SteamId().ToUint64()),
+		Steamid2:               proto.Uint64(friend.ToUint64()),
+		Count:                  proto.Uint32(options.Count),
+		MostRecentConversation: proto.Bool(options.MostRecentConversation),
+		BbcodeFormat:           proto.Bool(options.BBCode),
+		StartOrdinal:           proto.Uint32(options.StartOrdinal),
+		OrdinalLast:            proto.Uint32(options.EndOrdinal),
+	}
+	if !options.StartTime.IsZero() {
+		req.Rtime32StartTime = proto.Uint32(uint32(options.StartTime.Unix()))
+	}
+	if !options.EndTime.IsZero() {
+		req.TimeLast = proto.Uint32(uint32(options.EndTime.Unix()))
+	}
+	return s.client.callServiceMethod("FriendMessages.GetRecentMessages#1", req, func(packet *protocol.Packet) {
+		body := new(unified.CFriendMessages_GetRecentMessages_Response)
+		msg := packet.ReadProtoMsg(body)
+		var messages []FriendHistoryMessage
+		for _, message := range body.GetMessages() {
+			messages = append(messages, FriendHistoryMessage{
+				SenderId:  s.client.accountSteamId(message.GetAccountid(), steamlang.EAccountType_Individual),
+				Message:   message.GetMessage(),
+				Timestamp: time.Unix(int64(message.GetTimestamp()), 0),
+				Ordinal:   message.GetOrdinal(),
+			})
+		}
+		s.client.Emit(&FriendMessageHistoryEvent{
+			JobId:         packet.TargetJobId,
+			Result:        serviceMethodResult(msg),
+			FriendId:      friend,
+			Messages:      messages,
+			MoreAvailable: body.GetMoreAvailable(),
+		})
+	})
+}
+
+// Requests the friends we recently chatted with and their number of unread
+// messages. If since isn't zero, only sessions with messages after it are
+// returned. A FriendMessageSessionsEvent with the returned job id is fired in response.
+func (s *Social) GetActiveMessageSessions(since time.Time) protocol.JobId {
+	req := new(unified.CFriendsMessages_GetActiveMessageSessions_Request)
+	if !since.IsZero() {
+		req.LastmessageSince = proto.Uint32(uint32(since.Unix()))
+	}
+	return s.client.callServiceMethod("FriendMessages.GetActiveMessageSessions#1", req, func(packet *protocol.Packet) {
+		body := new(unified.CFriendsMessages_GetActiveMessageSessions_Response)
+		msg := packet.ReadProtoMsg(body)
+		var sessions []FriendMessageSession
+		for _, session := range body.GetMessageSessions() {
+			sessions = append(sessions, FriendMessageSession{
+				FriendId:    s.client.accountSteamId(session.GetAccountidFriend(), steamlang.EAccountType_Individual),
+				LastMessage: time.Unix(int64(session.GetLastMessage()), 0),
+				LastView:    time.Unix(int64(session.GetLastView()), 0),
+				UnreadCount: session.GetUnreadMessageCount(),
+			})
+		}
+		s.client.Emit(&FriendMessageSessionsEvent{
+			JobId:     packet.TargetJobId,
+			Result:    serviceMethodResult(msg),
+			Sessions:  sessions,
+			Timestamp: time.Unix(int64(body.GetTimestamp()), 0),
+		})
+	})
+}
+
 // Attempts to join a chat room
+//
+// Deprecated: Steam retired the legacy clan chat, use the ChatRoom group chats.
 func (s *Social) JoinChat(id steamid.SteamId) {
 	chatId := id.ClanToChat()
 	s.client.Write(protocol.NewClientMsg(&steamlang.MsgClientJoinChat{
@@ -168,6 +767,8 @@ func (s *Social) JoinChat(id steamid.SteamId) {
 }
 
 // Attempts to leave a chat room
+//
+// Deprecated: Steam retired the legacy clan chat, use the ChatRoom group chats.
 func (s *Social) LeaveChat(id steamid.SteamId) {
 	chatId := id.ClanToChat()
 	payload := new(bytes.Buffer)
@@ -181,6 +782,8 @@ func (s *Social) LeaveChat(id steamid.SteamId) {
 }
 
 // Kicks the specified chat member from the given chat room
+//
+// Deprecated: Steam retired the legacy clan chat, use the ChatRoom group chats.
 func (s *Social) KickChatMember(room steamid.SteamId, user steamid.SteamId) {
 	chatId := room.ClanToChat()
 	s.client.Write(protocol.NewClientMsg(&steamlang.MsgClientChatAction{
@@ -191,6 +794,8 @@ func (s *Social) KickChatMember(room steamid.SteamId, user steamid.SteamId) {
 }
 
 // Bans the specified chat member from the given chat room
+//
+// Deprecated: Steam retired the legacy clan chat, use the ChatRoom group chats.
 func (s *Social) BanChatMember(room steamid.SteamId, user steamid.SteamId) {
 	chatId := room.ClanToChat()
 	s.client.Write(protocol.NewClientMsg(&steamlang.MsgClientChatAction{
@@ -201,6 +806,8 @@ func (s *Social) BanChatMember(room steamid.SteamId, user steamid.SteamId) {
 }
 
 // Unbans the specified chat member from the given chat room
+//
+// Deprecated: Steam retired the legacy clan chat, use the ChatRoom group chats.
 func (s *Social) UnbanChatMember(room steamid.SteamId, user steamid.SteamId) {
 	chatId := room.ClanToChat()
 	s.client.Write(protocol.NewClientMsg(&steamlang.MsgClientChatAction{
@@ -212,12 +819,18 @@ func (s *Social) UnbanChatMember(room steamid.SteamId, user steamid.SteamId) {
 
 func (s *Social) HandlePacket(packet *protocol.Packet) {
 	switch packet.EMsg {
+	case steamlang.EMsg_ClientLogOnResponse:
+		s.handleLogOnResponse(packet)
 	case steamlang.EMsg_ClientPersonaState:
 		s.handlePersonaState(packet)
 	case steamlang.EMsg_ClientClanState:
 		s.handleClanState(packet)
 	case steamlang.EMsg_ClientFriendsList:
 		s.handleFriendsList(packet)
+	case steamlang.EMsg_ClientFriendsGroupsList:
+		s.handleFriendGroupsList(packet)
+	case steamlang.EMsg_ClientPlayerNicknameList:
+		s.handleNicknameList(packet)
 	case steamlang.EMsg_ClientFriendMsgIncoming:
 		s.handleFriendMsg(packet)
 	case steamlang.EMsg_ClientAccountInfo:
@@ -240,6 +853,75 @@ func (s *Social) HandlePacket(packet *protocol.Packet) {
 		s.handleProfileInfoResponse(packet)
 	case steamlang.EMsg_ClientFSGetFriendMessageHistoryResponse:
 		s.handleFriendMessageHistoryResponse(packet)
+	case steamlang.EMsg_ClientInviteToGame:
+		s.handleGameInvite(packet)
+	case steamlang.EMsg_ClientMMSInviteToLobby:
+		s.handleLobbyInvite(packet)
+	case steamlang.EMsg_ServiceMethod:
+		s.handleServiceMethod(packet)
+	}
+}
+
+func (s *Social) handleGameInvite(packet *protocol.Packet) {
+	body := new(protobuf.CMsgClientInviteToGame)
+	packet.ReadProtoMsg(body)
+	s.client.Emit(&GameInviteEvent{
+		FriendId:      steamid.SteamId(body.GetSteamIdSrc()),
+		ConnectString: body.GetConnectString(),
+		RemotePlay:    body.GetRemotePlay(),
+	})
+}
+
+func (s *Social) handleLobbyInvite(packet *protocol.Packet) {
+	body := new(protobuf.CMsgClientMMSInviteToLobby)
+	packet.ReadProtoMsg(body)
+	s.client.Emit(&LobbyInviteEvent{
+		AppId:   body.GetAppId(),
+		LobbyId: steamid.SteamId(body.GetSteamIdLobby()),
+	})
+}
+
+// The Steam client sends lobby invites as messages like
+// [lobbyinvite lobbyid="109775241046516497"][/lobbyinvite]
+var lobbyInvitePattern = regexp.MustCompile(`\[lobbyinvite lobbyid="?(\d+)"?\]`)
+
+// Fires a LobbyInviteEvent for a lobby invite sent as a friend message
+func (s *Social) emitLobbyInviteMessage(friendId steamid.SteamId, message string) {
+	match := lobbyInvitePattern.FindStringSubmatch(message)
+	if match == nil {
+		return
+	}
+	lobby, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return
+	}
+	event := &LobbyInviteEvent{
+		FriendId: friendId,
+		LobbyId:  steamid.SteamId(lobby),
+	}
+	if friend, err := s.Friends.ById(friendId); err == nil {
+		event.AppId = friend.GameAppId
+	}
+	s.client.Emit(event)
+}
+
+func (s *Social) handleServiceMethod(packet *protocol.Packet) {
+	switch serviceMethodName(packet) {
+	case "FriendMessagesClient.IncomingMessage#1":
+		s.handleIncomingFriendMessage(packet)
+	case "FriendMessagesClient.NotifyAckMessageEcho#1":
+		s.handleFriendMessageAckEcho(packet)
+	}
+}
+
+func (s *Social) handleLogOnResponse(packet *protocol.Packet) {
+	if !packet.IsProto {
+		return
+	}
+	body := new(protobuf.CMsgClientLogonResponse)
+	packet.ReadProtoMsg(body)
+	if steamlang.EResult(body.GetEresult()) == steamlang.EResult_OK {
+		s.loadCache()
 	}
 }
 
@@ -266,7 +948,7 @@ func (s *Social) handleFriendsList(packet *protocol.Packet) {
 					SteamId:      steamId,
 					Relationship: rel,
 				})
-
+				s.Groups.SetRelationship(steamId, rel)
 			}
 			if list.GetBincremental() {
 				s.client.Emit(&GroupStateEvent{steamId, rel})
@@ -280,7 +962,7 @@ func (s *Social) handleFriendsList(packet *protocol.Packet) {
 					SteamId:      steamId,
 					Relationship: rel,
 				})
-
+				s.Friends.SetRelationship(steamId, rel)
 			}
 			if list.GetBincremental() {
 				s.client.Emit(&FriendStateEvent{steamId, rel})
@@ -291,9 +973,65 @@ func (s *Social) handleFriendsList(packet *protocol.Packet) {
 		}
 	}
 	if !list.GetBincremental() {
+		// drop everyone we lost while being offline
+		s.Friends.Retain(friends)
+		s.Groups.Retain(friends)
 		s.RequestFriendListInfo(friends, protocol.EClientPersonaStateFlag_DefaultInfoRequest)
 		s.client.Emit(&FriendsListEvent{})
 	}
+	s.saveCache()
+}
+
+func (s *Social) handleFriendGroupsList(packet *protocol.Packet) {
+	list := new(protobuf.CMsgClientFriendsGroupsList)
+	packet.ReadProtoMsg(list)
+	if !list.GetBincremental() {
+		s.FriendGroups.Restore(nil)
+	}
+	for _, group := range list.GetFriendGroups() {
+		if list.GetBremoval() {
+			s.FriendGroups.Remove(group.GetNGroupID())
+		} else {
+			s.FriendGroups.Add(group.GetNGroupID(), group.GetStrGroupName())
+		}
+	}
+	for _, membership := range list.GetMemberships() {
+		id := membership.GetNGroupID()
+		member := steamid.SteamId(membership.GetUlSteamID())
+		if list.GetBremoval() {
+			s.FriendGroups.RemoveMember(id, member)
+		} else {
+			s.FriendGroups.AddMember(id, member)
+		}
+	}
+	s.client.Emit(&FriendGroupsListEvent{Incremental: list.GetBincremental()})
+	s.saveCache()
+}
+
+func (s *Social) handleNicknameList(packet *protocol.Packet) {
+	list := new(protobuf.CMsgClientPlayerNicknameList)
+	packet.ReadProtoMsg(list)
+	nicknames := make(map[steamid.SteamId]string)
+	for _, nickname := range list.GetNicknames() {
+		id := steamid.SteamId(nickname.GetSteamid())
+		if list.GetRemoval() {
+			nicknames[id] = ""
+		} else {
+			nicknames[id] = nickname.GetNickname()
+		}
+		if list.GetIncremental() {
+			s.Friends.SetNickname(id, nicknames[id])
+		}
+	}
+	if !list.GetIncremental() {
+		s.Friends.SetNicknames(nicknames)
+	}
+	s.client.Emit(&NicknameListEvent{
+		Incremental: list.GetIncremental(),
+		Removal:     list.GetRemoval(),
+		Nicknames:   nicknames,
+	})
+	s.saveCache()
 }
 
 func (s *Social) handlePersonaState(packet *protocol.Packet) {
@@ -302,6 +1040,8 @@ func (s *Social) handlePersonaState(packet *protocol.Packet) {
 	flags := steamlang.EClientPersonaStateFlag(list.GetStatusFlags())
 	for _, friend := range list.GetFriends() {
 		id := steamid.SteamId(friend.GetFriendid())
+		var old, merged socialcache.Friend
+		var isFriend bool
 		if id == s.client.SteamId() { // this is our client id
 			s.mutex.Lock()
 			if friend.GetPlayerName() != "" {
@@ -313,24 +1053,8 @@ func (s *Social) handlePersonaState(packet *protocol.Packet) {
 			}
 			s.mutex.Unlock()
 		} else if id.GetAccountType() == int32(steamlang.EAccountType_Individual) {
-			if (flags & steamlang.EClientPersonaStateFlag_PlayerName) == steamlang.EClientPersonaStateFlag_PlayerName {
-				if friend.GetPlayerName() != "" {
-					s.Friends.SetName(id, friend.GetPlayerName())
-				}
-			}
-			if (flags & steamlang.EClientPersonaStateFlag_Presence) == steamlang.EClientPersonaStateFlag_Presence {
-				avatar := friend.GetAvatarHash()
-				if protocol.ValidAvatar(avatar) {
-					s.Friends.SetAvatar(id, avatar)
-				}
-				s.Friends.SetPersonaState(id, steamlang.EPersonaState(friend.GetPersonaState()))
-				s.Friends.SetPersonaStateFlags(id, steamlang.EPersonaStateFlag(friend.GetPersonaStateFlags()))
-			}
-			if (flags & steamlang.EClientPersonaStateFlag_GameDataBlob) == steamlang.EClientPersonaStateFlag_GameDataBlob {
-				s.Friends.SetGameAppId(id, friend.GetGamePlayedAppId())
-				s.Friends.SetGameId(id, friend.GetGameid())
-				s.Friends.SetGameName(id, friend.GetGameName())
-			}
+			old, merged, isFriend = s.Friends.MergePersonaState(s.friendFromPersonaState(friend), flags)
+			s.handleClanOfficer(id, friend, flags)
 		} else if id.GetAccountType() == int32(steamlang.EAccountType_Clan) {
 			if (flags & steamlang.EClientPersonaStateFlag_PlayerName) == steamlang.EClientPersonaStateFlag_PlayerName {
 				if friend.GetPlayerName() != "" {
@@ -363,13 +1087,170 @@ func (s *Social) handlePersonaState(packet *protocol.Packet) {
 			LastLogOn:              friend.GetLastLogon(),
 			ClanRank:               friend.GetClanRank(),
 			ClanTag:                friend.GetClanTag(),
+			LastSeenOnline:         friend.GetLastSeenOnline(),
 			OnlineSessionInstances: friend.GetOnlineSessionInstances(),
 			PersonaSetByUser:       friend.GetPersonaSetByUser(),
+			NamePendingReview:      unknownBool(friend, personaFieldPlayerNamePendingReview),
+			AvatarPendingReview:    unknownBool(friend, personaFieldAvatarPendingReview),
 			RichPresence:           friend.GetRichPresence(),
 		})
+		if isFriend {
+			s.emitFriendChanges(old, merged, flags)
+		}
+	}
+	s.saveCache()
+}
+
+// Officers of a clan are sent as persona states with the clan as source
+// after GetClanOfficers.
+func (s *Social) handleClanOfficer(id steamid.SteamId, friend *protobuf.CMsgClientPersonaState_Friend, flags steamlang.EClientPersonaStateFlag) {
+	source := steamid.SteamId(friend.GetSteamidSource())
+	if flags&steamlang.EClientPersonaStateFlag_UserClanRank == 0 || source.GetAccountType() != int32(steamlang.EAccountType_Clan) {
+		return
+	}
+	rank := steamlang.EClanRank(friend.GetClanRank())
+	if rank == steamlang.EClanRank_None || rank == steamlang.EClanRank_Member {
+		return
+	}
+	officer := socialcache.ClanOfficer{
+		SteamId: id,
+		Name:    friend.GetPlayerName(),
+		Rank:    rank,
+	}
+	source = source.ChatToClan()
+	s.mutex.Lock()
+	lookup, ok := s.officerLookups[source]
+	if ok {
+		lookup.officers[id] = officer
+	}
+	s.mutex.Unlock()
+	if ok {
+		s.completeOfficerLookup(source, lookup, false)
+	} else {
+		s.ClanOfficers.Add(source, officer)
+	}
+}
+
+// Fields of CMsgClientPersonaState.Friend that are missing in the generated
+// code, they are read from its unknown fields instead
+const (
+	personaFieldPlayerNamePendingReview protowire.Number = 78
+	personaFieldAvatarPendingReview     protowire.Number = 79
+)
+
+// Returns the value of a bool field that the message doesn't know, false if
+// it's not set
+func unknownBool(msg proto.Message, number protowire.Number) bool {
+	b := msg.ProtoReflect().GetUnknown()
+	value := false
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+		if num == number && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return false
+			}
+			value = v != 0
+			b = b[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return false
+		}
+		b = b[n:]
+	}
+	return value
+}
+
+func (s *Social) friendFromPersonaState(friend *protobuf.CMsgClientPersonaState_Friend) socialcache.Friend {
+	f := socialcache.Friend{
+		SteamId:                    steamid.SteamId(friend.GetFriendid()),
+		Name:                       friend.GetPlayerName(),
+		PersonaState:               steamlang.EPersonaState(friend.GetPersonaState()),
+		PersonaStateFlags:          steamlang.EPersonaStateFlag(friend.GetPersonaStateFlags()),
+		GameAppId:                  friend.GetGamePlayedAppId(),
+		GameId:                     friend.GetGameid(),
+		GameName:                   friend.GetGameName(),
+		PersonaSetByUser:           friend.GetPersonaSetByUser(),
+		NamePendingReview:          unknownBool(friend, personaFieldPlayerNamePendingReview),
+		AvatarPendingReview:        unknownBool(friend, personaFieldAvatarPendingReview),
+		OnlineSessionInstances:     friend.GetOnlineSessionInstances(),
+		SourceSteamId:              steamid.SteamId(friend.GetSteamidSource()),
+		GameServerIp:               friend.GetGameServerIp(),
+		GameServerPort:             friend.GetGameServerPort(),
+		QueryPort:                  friend.GetQueryPort(),
+		GameDataBlob:               friend.GetGameDataBlob(),
+		LastLogOff:                 unixTime(friend.GetLastLogoff()),
+		LastLogOn:                  unixTime(friend.GetLastLogon()),
+		LastSeenOnline:             unixTime(friend.GetLastSeenOnline()),
+		ClanRank:                   friend.GetClanRank(),
+		ClanTag:                    friend.GetClanTag(),
+		ClanOggAppId:               friend.GetClanData().GetOggAppId(),
+		ClanChatGroupId:            friend.GetClanData().GetChatGroupId(),
+		BroadcastId:                friend.GetBroadcastId(),
+		WatchingBroadcastAccountId: friend.GetWatchingBroadcastAccountid(),
+		WatchingBroadcastAppId:     friend.GetWatchingBroadcastAppid(),
+		WatchingBroadcastViewers:   friend.GetWatchingBroadcastViewers(),
+		WatchingBroadcastTitle:     friend.GetWatchingBroadcastTitle(),
+	}
+	if avatar := friend.GetAvatarHash(); protocol.ValidAvatar(avatar) {
+		f.Avatar = avatar
+	}
+	if len(friend.GetRichPresence()) > 0 {
+		f.RichPresence = make(map[string]string, len(friend.GetRichPresence()))
+		for _, kv := range friend.GetRichPresence() {
+			f.RichPresence[kv.GetKey()] = kv.GetValue()
+		}
+	}
+	return f
+}
+
+// Fires the change events of a friend, but only for the parts of the persona
+// state that we knew before. Otherwise the first update after logging on would
+// look like every friend came online.
+func (s *Social) emitFriendChanges(old, merged socialcache.Friend, flags steamlang.EClientPersonaStateFlag) {
+	stateFlags := steamlang.EClientPersonaStateFlag_Status | steamlang.EClientPersonaStateFlag_Presence
+	if flags&stateFlags != 0 && old.Received&stateFlags != 0 && old.PersonaState != merged.PersonaState {
+		s.client.Emit(&FriendStateChangedEvent{
+			SteamId:  merged.SteamId,
+			OldState: old.PersonaState,
+			State:    merged.PersonaState,
+		})
+	}
+	if flags&steamlang.EClientPersonaStateFlag_PlayerName != 0 && old.HasReceived(steamlang.EClientPersonaStateFlag_PlayerName) && old.Name != merged.Name {
+		s.client.Emit(&FriendNameChangedEvent{
+			SteamId: merged.SteamId,
+			OldName: old.Name,
+			Name:    merged.Name,
+		})
+	}
+	gameFlags := steamlang.EClientPersonaStateFlag_Presence | steamlang.EClientPersonaStateFlag_GameExtraInfo | steamlang.EClientPersonaStateFlag_GameDataBlob
+	if flags&gameFlags != 0 && old.Received&gameFlags != 0 && (old.GameAppId != merged.GameAppId || old.GameId != merged.GameId) {
+		s.client.Emit(&FriendGameChangedEvent{
+			SteamId:     merged.SteamId,
+			OldAppId:    old.GameAppId,
+			OldGameId:   old.GameId,
+			OldGameName: old.GameName,
+			AppId:       merged.GameAppId,
+			GameId:      merged.GameId,
+			GameName:    merged.GameName,
+		})
 	}
 }
 
+// Zero stays the zero time instead of 1970
+func unixTime(seconds uint32) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(seconds), 0)
+}
+
 func (s *Social) handleClanState(packet *protocol.Packet) {
 	body := new(protobuf.CMsgClientClanState)
 	packet.ReadProtoMsg(body)
@@ -432,6 +1313,7 @@ func (s *Social) handleClanState(packet *protocol.Packet) {
 		Events:              events,
 		Announcements:       announcements,
 	})
+	s.saveCache()
 }
 
 func (s *Social) handleFriendResponse(packet *protocol.Packet) {
@@ -479,7 +1361,11 @@ func (s *Social) handleChatEnter(packet *protocol.Packet) {
 	clanId := steamid.SteamId(body.SteamIdClan)
 	s.Chats.Add(socialcache.Chat{SteamId: chatId, GroupId: clanId})
 	for i := 0; i < int(count); i++ {
-		id, chatPerm, clanPerm := readChatMember(reader)
+		id, chatPerm, clanPerm, err := readChatMember(reader)
+		if err != nil {
+			s.client.Errorf("social: failed to read member %d of chat %v: %v", i, chatId, err)
+			break
+		}
 		rwu.ReadBytes(reader, 6) // No idea what this is
 		s.Chats.AddChatMember(chatId, socialcache.ChatMember{
 			SteamId:         steamid.SteamId(id),
@@ -487,6 +1373,7 @@ func (s *Social) handleChatEnter(packet *protocol.Packet) {
 			ClanPermissions: clanPerm,
 		})
 	}
+	s.saveCache()
 	s.client.Emit(&ChatEnterEvent{
 		ChatRoomId:    steamid.SteamId(body.SteamIdChat),
 		FriendId:      steamid.SteamId(body.SteamIdFriend),
@@ -511,16 +1398,21 @@ func (s *Social) handleChatMemberInfo(packet *protocol.Packet) {
 		rwu.ReadByte(reader) // 0
 		stateChange := steamlang.EChatMemberStateChange(state)
 		if stateChange == steamlang.EChatMemberStateChange_Entered {
-			_, chatPerm, clanPerm := readChatMember(reader)
-			s.Chats.AddChatMember(chatId, socialcache.ChatMember{
-				SteamId:         steamid.SteamId(actedOn),
-				ChatPermissions: chatPerm,
-				ClanPermissions: clanPerm,
-			})
+			_, chatPerm, clanPerm, err := readChatMember(reader)
+			if err != nil {
+				s.client.Errorf("social: failed to read member %v of chat %v: %v", steamid.SteamId(actedOn), chatId, err)
+			} else {
+				s.Chats.AddChatMember(chatId, socialcache.ChatMember{
+					SteamId:         steamid.SteamId(actedOn),
+					ChatPermissions: chatPerm,
+					ClanPermissions: clanPerm,
+				})
+			}
 		} else if stateChange == steamlang.EChatMemberStateChange_Banned || stateChange == steamlang.EChatMemberStateChange_Kicked ||
 			stateChange == steamlang.EChatMemberStateChange_Disconnected || stateChange == steamlang.EChatMemberStateChange_Left {
 			s.Chats.RemoveChatMember(chatId, steamid.SteamId(actedOn))
 		}
+		s.saveCache()
 		stateInfo := StateChangeDetails{
 			ChatterActedOn: steamid.SteamId(actedOn),
 			StateChange:    steamlang.EChatMemberStateChange(stateChange),
@@ -534,7 +1426,10 @@ func (s *Social) handleChatMemberInfo(packet *protocol.Packet) {
 	}
 }
 
-func readChatMember(r io.Reader) (steamid.SteamId, steamlang.EChatPermission, steamlang.EClanPermission) {
+// Reads the binary KeyValues that describe a member of a chat, after the
+// type of the MessageObject section was read. The fields are read one
+// after another, so the last read fails if the payload was too short.
+func readChatMember(r io.Reader) (steamid.SteamId, steamlang.EChatPermission, steamlang.EClanPermission, error) {
 	rwu.ReadString(r) // MessageObject
 	rwu.ReadByte(r)   // 7
 	rwu.ReadString(r) // steamid
@@ -544,8 +1439,8 @@ func readChatMember(r io.Reader) (steamid.SteamId, steamlang.EChatPermission, st
 	chat, _ := rwu.ReadInt32(r)
 	rwu.ReadByte(r)   // 2
 	rwu.ReadString(r) // Details
-	clan, _ := rwu.ReadInt32(r)
-	return steamid.SteamId(id), steamlang.EChatPermission(chat), steamlang.EClanPermission(clan)
+	clan, err := rwu.ReadInt32(r)
+	return steamid.SteamId(id), steamlang.EChatPermission(chat), steamlang.EClanPermission(clan), err
 }
 
 func (s *Social) handleChatActionResult(packet *protocol.Packet) {
@@ -614,3 +1509,49 @@ func (s *Social) handleFriendMessageHistoryResponse(packet *protocol.Packet) {
 		})
 	}
 }
+
+func (s *Social) handleIncomingFriendMessage(packet *protocol.Packet) {
+	body := new(unified.CFriendMessages_IncomingMessage_Notification)
+	packet.ReadProtoMsg(body)
+	friendId := steamid.SteamId(body.GetSteamidFriend())
+	entryType := steamlang.EChatEntryType(body.GetChatEntryType())
+	timestamp := time.Unix(int64(body.GetRtime32ServerTimestamp()), 0)
+	if body.GetLocalEcho() {
+		if entryType == steamlang.EChatEntryType_Typing {
+			return
+		}
+		s.client.Emit(&FriendMessageEchoEvent{
+			FriendId:        friendId,
+			EntryType:       entryType,
+			Message:         body.GetMessage(),
+			MessageNoBBCode: body.GetMessageNoBbcode(),
+			Timestamp:       timestamp,
+			Ordinal:         body.GetOrdinal(),
+		})
+	} else if entryType == steamlang.EChatEntryType_Typing {
+		s.client.Emit(&FriendTypingEvent{FriendId: friendId})
+	} else {
+		s.client.Emit(&FriendMessageEvent{
+			FriendId:           friendId,
+			EntryType:          entryType,
+			Message:            body.GetMessage(),
+			MessageNoBBCode:    body.GetMessageNoBbcode(),
+			Timestamp:          timestamp,
+			Ordinal:            body.GetOrdinal(),
+			FromLimitedAccount: body.GetFromLimitedAccount(),
+			LowPriority:        body.GetLowPriority(),
+		})
+		if entryType == steamlang.EChatEntryType_ChatMsg {
+			s.emitLobbyInviteMessage(friendId, body.GetMessage())
+		}
+	}
+}
+
+func (s *Social) handleFriendMessageAckEcho(packet *protocol.Packet) {
+	body := new(unified.CFriendMessages_AckMessage_Notification)
+	packet.ReadProtoMsg(body)
+	s.client.Emit(&FriendMessageAckEvent{
+		FriendId:  steamid.SteamId(body.GetSteamidPartner()),
+		Timestamp: time.Unix(int64(body.GetTimestamp()), 0),
+	})
+}
@@ -3,8 +3,10 @@ package steam
 import (
 	"time"
 
+	"github.com/vuquang23/go-steam/protocol"
 	"github.com/vuquang23/go-steam/protocol/protobuf"
 	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/socialcache"
 	"github.com/vuquang23/go-steam/steamid"
 )
 
@@ -46,13 +48,59 @@ type PersonaStateEvent struct {
 	Avatar                 []byte
 	LastLogOff             uint32
 	LastLogOn              uint32
+	LastSeenOnline         uint32
 	ClanRank               uint32
 	ClanTag                string
 	OnlineSessionInstances uint32
 	PersonaSetByUser       bool
+	NamePendingReview      bool
+	AvatarPendingReview    bool
 	RichPresence           []*protobuf.CMsgClientPersonaState_Friend_KV
 }
 
+// Fired when a friend went online, offline, away and so on
+type FriendStateChangedEvent struct {
+	SteamId  steamid.SteamId `json:",string"`
+	OldState steamlang.EPersonaState
+	State    steamlang.EPersonaState
+}
+
+func (f *FriendStateChangedEvent) WentOnline() bool {
+	return f.OldState == steamlang.EPersonaState_Offline && f.State != steamlang.EPersonaState_Offline
+}
+
+func (f *FriendStateChangedEvent) WentOffline() bool {
+	return f.OldState != steamlang.EPersonaState_Offline && f.State == steamlang.EPersonaState_Offline
+}
+
+// Fired when a friend changed their name
+type FriendNameChangedEvent struct {
+	SteamId steamid.SteamId `json:",string"`
+	OldName string
+	Name    string
+}
+
+// Fired when a friend started, stopped or switched a game
+type FriendGameChangedEvent struct {
+	SteamId     steamid.SteamId `json:",string"`
+	OldAppId    uint32
+	OldGameId   uint64 `json:",string"`
+	OldGameName string
+	AppId       uint32
+	GameId      uint64 `json:",string"`
+	GameName    string // only set for non-Steam games
+}
+
+// Whether the friend started a game without playing one before
+func (f *FriendGameChangedEvent) Started() bool {
+	return f.OldAppId == 0 && f.OldGameId == 0 && (f.AppId != 0 || f.GameId != 0)
+}
+
+// Whether the friend stopped playing
+func (f *FriendGameChangedEvent) Stopped() bool {
+	return f.AppId == 0 && f.GameId == 0
+}
+
 // Fired when a clan's state has been changed
 type ClanStateEvent struct {
 	ClanId              steamid.SteamId `json:",string"`
@@ -158,3 +206,167 @@ type ProfileInfoEvent struct {
 	Headline    string
 	Summary     string
 }
+
+// Fired when a friend sends a message through the FriendMessages service
+type FriendMessageEvent struct {
+	FriendId           steamid.SteamId `json:",string"`
+	EntryType          steamlang.EChatEntryType
+	Message            string // may contain BBCode
+	MessageNoBBCode    string
+	Timestamp          time.Time
+	Ordinal            uint32
+	FromLimitedAccount bool
+	LowPriority        bool
+}
+
+// Fired when a friend is typing a message
+type FriendTypingEvent struct {
+	FriendId steamid.SteamId `json:",string"`
+}
+
+// Fired when a message to a friend was sent from another session of ours
+type FriendMessageEchoEvent struct {
+	FriendId        steamid.SteamId `json:",string"`
+	EntryType       steamlang.EChatEntryType
+	Message         string
+	MessageNoBBCode string
+	Timestamp       time.Time
+	Ordinal         uint32
+}
+
+// Fired when another session of ours has read the messages of a friend
+type FriendMessageAckEvent struct {
+	FriendId  steamid.SteamId `json:",string"`
+	Timestamp time.Time
+}
+
+// Fired in response to SendFriendMessage
+type FriendMessageSentEvent struct {
+	JobId           protocol.JobId
+	Result          steamlang.EResult
+	FriendId        steamid.SteamId `json:",string"`
+	Message         string          // the message as Steam modified it
+	MessageNoBBCode string
+	Timestamp       time.Time
+	Ordinal         uint32
+}
+
+// Fired in response to GetRecentMessages
+type FriendMessageHistoryEvent struct {
+	JobId         protocol.JobId
+	Result        steamlang.EResult
+	FriendId      steamid.SteamId        `json:",string"`
+	Messages      []FriendHistoryMessage // newest first
+	MoreAvailable bool
+}
+
+type FriendHistoryMessage struct {
+	SenderId  steamid.SteamId `json:",string"`
+	Message   string
+	Timestamp time.Time
+	Ordinal   uint32
+}
+
+// Fired in response to GetActiveMessageSessions
+type FriendMessageSessionsEvent struct {
+	JobId    protocol.JobId
+	Result   steamlang.EResult
+	Sessions []FriendMessageSession
+	// Pass as since to the next GetActiveMessageSessions to poll for updates
+	Timestamp time.Time
+}
+
+type FriendMessageSession struct {
+	FriendId    steamid.SteamId `json:",string"`
+	LastMessage time.Time
+	LastView    time.Time
+	UnreadCount uint32
+}
+
+// Fired when the friend groups were received after logging on or changed,
+// possibly from another session. See Social.FriendGroups.
+type FriendGroupsListEvent struct {
+	Incremental bool
+}
+
+// Fired in response to CreateFriendGroup
+type FriendGroupCreatedEvent struct {
+	JobId   protocol.JobId
+	Result  steamlang.EResult
+	GroupId int32
+	Name    string
+}
+
+// Fired in response to RenameFriendGroup, DeleteFriendGroup,
+// AddFriendToGroup and RemoveFriendFromGroup
+type FriendGroupResultEvent struct {
+	JobId   protocol.JobId
+	Result  steamlang.EResult
+	GroupId int32
+}
+
+// Fired when the nicknames of our friends were received after logging on
+// or changed, possibly from another session
+type NicknameListEvent struct {
+	Incremental bool
+	// Whether the nicknames were removed, in which case they are empty
+	Removal   bool
+	Nicknames map[steamid.SteamId]string
+}
+
+// Fired in response to SetNickname
+type NicknameSetEvent struct {
+	JobId    protocol.JobId
+	Result   steamlang.EResult
+	SteamId  steamid.SteamId `json:",string"`
+	Nickname string
+}
+
+// Fired in response to GetSteamLevels
+type SteamLevelsEvent struct {
+	JobId  protocol.JobId
+	Levels map[steamid.SteamId]uint32
+}
+
+// Fired in response to GetPersonaNameHistory
+type PersonaNameHistoryEvent struct {
+	JobId     protocol.JobId
+	Histories []PersonaNameHistory
+}
+
+type PersonaNameHistory struct {
+	SteamId steamid.SteamId `json:",string"`
+	Result  steamlang.EResult
+	// The previous names with the time they were taken
+	Names []socialcache.PersonaName
+}
+
+// Fired in response to GetClanOfficers once the officers arrived. They're
+// also stored in Social.ClanOfficers. Result is EResult_Timeout if Steam
+// didn't respond in time and EResult_NoConnection if we disconnected.
+type ClanOfficersEvent struct {
+	JobId        protocol.JobId
+	Result       steamlang.EResult
+	ClanId       steamid.SteamId `json:",string"`
+	OfficerCount int
+	// Sorted by rank. It only has fewer than OfficerCount officers if Steam
+	// didn't send all of them in time.
+	Officers []socialcache.ClanOfficer
+}
+
+// Fired when a friend invited us into their game
+type GameInviteEvent struct {
+	FriendId steamid.SteamId `json:",string"`
+	// Passed to the game when joining, like "+connect 1.2.3.4:27015"
+	ConnectString string
+	RemotePlay    string
+}
+
+// Fired when we were invited into a lobby, either by Steam or by a friend
+// with a message. FriendId is only known for messages and AppId only if the
+// friend is playing the game.
+type LobbyInviteEvent struct {
+	FriendId steamid.SteamId `json:",string"`
+	AppId    uint32
+	LobbyId  steamid.SteamId `json:",string"`
+}
@@ -0,0 +1,190 @@
+package socialcache
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vuquang23/go-steam/protocol/protobuf/unified"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Chat room groups list is a thread safe map of the group chats we are in.
+// They can be iterated over like so:
+//
+//	for id, group := range client.ChatRoom.Groups.GetCopy() {
+//		log.Println(id, group.Name)
+//	}
+type ChatRoomGroupsList struct {
+	mutex sync.RWMutex
+	byId  map[uint64]*ChatRoomGroup
+}
+
+// Returns a new chat room groups list
+func NewChatRoomGroupsList() *ChatRoomGroupsList {
+	return &ChatRoomGroupsList{byId: make(map[uint64]*ChatRoomGroup)}
+}
+
+// Adds a group to the list or replaces it. Members of a group that is
+// already known are kept if the new group has none.
+func (list *ChatRoomGroupsList) Add(group ChatRoomGroup) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	group = group.copy()
+	if old, ok := list.byId[group.Id]; ok && group.Members == nil {
+		group.Members = old.Members
+	}
+	list.byId[group.Id] = &group
+}
+
+// Removes a group from the list
+func (list *ChatRoomGroupsList) Remove(id uint64) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	delete(list.byId, id)
+}
+
+// Returns a copy of the groups map
+func (list *ChatRoomGroupsList) GetCopy() map[uint64]ChatRoomGroup {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	glist := make(map[uint64]ChatRoomGroup)
+	for key, group := range list.byId {
+		glist[key] = group.copy()
+	}
+	return glist
+}
+
+// Returns a copy of the group with the given id
+func (list *ChatRoomGroupsList) ById(id uint64) (ChatRoomGroup, error) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	if val, ok := list.byId[id]; ok {
+		return val.copy(), nil
+	}
+	return ChatRoomGroup{}, errors.New("Chat room group not found")
+}
+
+// Returns the number of groups
+func (list *ChatRoomGroupsList) Count() int {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return len(list.byId)
+}
+
+// Updates the name, tagline, avatar and roles of a group
+func (list *ChatRoomGroupsList) SetHeader(id uint64, header ChatRoomGroupHeader) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		val.ChatRoomGroupHeader = header
+		val.Roles = copyRoles(header.Roles)
+	}
+}
+
+// Replaces the chat rooms of a group
+func (list *ChatRoomGroupsList) SetChatRooms(id uint64, defaultChatId uint64, rooms []ChatRoomChannel) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		val.DefaultChatId = defaultChatId
+		val.ChatRooms = append([]ChatRoomChannel(nil), rooms...)
+	}
+}
+
+// Adds a member to a group or replaces it
+func (list *ChatRoomGroupsList) AddMember(id uint64, member ChatRoomMember) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		if val.Members == nil {
+			val.Members = make(map[steamid.SteamId]ChatRoomMember)
+		}
+		val.Members[member.SteamId] = member
+	}
+}
+
+// Removes a member from a group
+func (list *ChatRoomGroupsList) RemoveMember(id uint64, member steamid.SteamId) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		delete(val.Members, member)
+	}
+}
+
+// The parts of a group that Steam sends when they change
+type ChatRoomGroupHeader struct {
+	Name          string
+	Tagline       string
+	Avatar        []byte
+	ClanId        steamid.SteamId `json:",string"`
+	OwnerId       steamid.SteamId `json:",string"`
+	AppId         uint32
+	DefaultRoleId uint64 `json:",string"`
+	Roles         []ChatRole
+}
+
+// A group chat with its chat rooms
+type ChatRoomGroup struct {
+	Id uint64 `json:",string"`
+	ChatRoomGroupHeader
+	DefaultChatId uint64 `json:",string"`
+	ChatRooms     []ChatRoomChannel
+	// Our rank in the group
+	Rank unified.EChatRoomGroupRank
+	// Only known after a member state change or the group state was requested
+	Members map[steamid.SteamId]ChatRoomMember
+}
+
+// Returns the chat room with the given id
+func (g *ChatRoomGroup) ChatRoom(chatId uint64) (ChatRoomChannel, bool) {
+	for _, room := range g.ChatRooms {
+		if room.Id == chatId {
+			return room, true
+		}
+	}
+	return ChatRoomChannel{}, false
+}
+
+func (g ChatRoomGroup) copy() ChatRoomGroup {
+	g.Roles = copyRoles(g.Roles)
+	g.ChatRooms = append([]ChatRoomChannel(nil), g.ChatRooms...)
+	if g.Members != nil {
+		members := make(map[steamid.SteamId]ChatRoomMember, len(g.Members))
+		for id, member := range g.Members {
+			members[id] = member
+		}
+		g.Members = members
+	}
+	return g
+}
+
+func copyRoles(roles []ChatRole) []ChatRole {
+	return append([]ChatRole(nil), roles...)
+}
+
+// A chat room (channel) of a group
+type ChatRoomChannel struct {
+	Id           uint64 `json:",string"`
+	Name         string
+	VoiceAllowed bool
+	LastMessage  time.Time
+	SortOrder    uint32
+}
+
+// A Chat Room Member
+type ChatRoomMember struct {
+	SteamId     steamid.SteamId `json:",string"`
+	State       unified.EChatRoomJoinState
+	Rank        unified.EChatRoomGroupRank
+	RoleIds     []uint64
+	KickExpires time.Time
+}
+
+// A role of a group, which grants members permissions
+type ChatRole struct {
+	Id      uint64 `json:",string"`
+	Name    string
+	Ordinal uint32
+}
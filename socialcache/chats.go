@@ -2,6 +2,7 @@ package socialcache
 
 import (
 	"errors"
+	"sort"
 	"sync"
 
 	"github.com/vuquang23/go-steam/protocol/steamlang"
@@ -13,6 +14,8 @@ import (
 // 	for id, chat := range client.Social.Chats.GetCopy() {
 // 		log.Println(id, chat.Name)
 // 	}
+//
+// Deprecated: Steam retired the legacy clan chat, use ChatRoomGroupsList.
 type ChatsList struct {
 	mutex sync.RWMutex
 	byId  map[steamid.SteamId]*Chat
@@ -69,6 +72,31 @@ func (list *ChatsList) RemoveChatMember(id steamid.SteamId, member steamid.Steam
 	delete(chat.ChatMembers, member)
 }
 
+// Returns a copy of all chats sorted by SteamId, see Snapshot
+func (list *ChatsList) Snapshot() []Chat {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	chats := make([]Chat, 0, len(list.byId))
+	for _, chat := range list.byId {
+		chats = append(chats, chat.copy())
+	}
+	sort.Slice(chats, func(i, j int) bool {
+		return chats[i].SteamId < chats[j].SteamId
+	})
+	return chats
+}
+
+// Replaces all chats
+func (list *ChatsList) Restore(chats []Chat) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.byId = make(map[steamid.SteamId]*Chat, len(chats))
+	for i := range chats {
+		chat := chats[i].copy()
+		list.byId[chat.SteamId] = &chat
+	}
+}
+
 // Returns a copy of the chats map
 func (list *ChatsList) GetCopy() map[steamid.SteamId]Chat {
 	list.mutex.RLock()
@@ -104,6 +132,17 @@ type Chat struct {
 	ChatMembers map[steamid.SteamId]ChatMember
 }
 
+func (c Chat) copy() Chat {
+	if c.ChatMembers != nil {
+		members := make(map[steamid.SteamId]ChatMember, len(c.ChatMembers))
+		for id, member := range c.ChatMembers {
+			members[id] = member
+		}
+		c.ChatMembers = members
+	}
+	return c
+}
+
 // A Chat Member
 type ChatMember struct {
 	SteamId         steamid.SteamId `json:",string"`
@@ -0,0 +1,165 @@
+package socialcache
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Friend groups list is a thread safe map of the groups (tags) we sorted our
+// friends into. They can be iterated over like so:
+//
+//	for id, group := range client.Social.FriendGroups.GetCopy() {
+//		log.Println(id, group.Name, len(group.Members))
+//	}
+type FriendGroupsList struct {
+	mutex sync.RWMutex
+	byId  map[int32]*FriendGroup
+}
+
+// Returns a new friend groups list
+func NewFriendGroupsList() *FriendGroupsList {
+	return &FriendGroupsList{byId: make(map[int32]*FriendGroup)}
+}
+
+// Adds a group to the list. The members of a group that is already known
+// are kept and only the name is updated.
+func (list *FriendGroupsList) Add(id int32, name string) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		val.Name = name
+		return
+	}
+	list.byId[id] = &FriendGroup{Id: id, Name: name}
+}
+
+// Removes a group from the list
+func (list *FriendGroupsList) Remove(id int32) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	delete(list.byId, id)
+}
+
+// Renames a group
+func (list *FriendGroupsList) SetName(id int32, name string) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		val.Name = name
+	}
+}
+
+// Adds a friend to a group
+func (list *FriendGroupsList) AddMember(id int32, member steamid.SteamId) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok && !val.HasMember(member) {
+		val.Members = append(val.Members, member)
+	}
+}
+
+// Removes a friend from a group
+func (list *FriendGroupsList) RemoveMember(id int32, member steamid.SteamId) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		for i, m := range val.Members {
+			if m == member {
+				val.Members = append(val.Members[:i:i], val.Members[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Returns the ids of the groups a friend is in
+func (list *FriendGroupsList) GroupsOf(member steamid.SteamId) []int32 {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	var ids []int32
+	for id, group := range list.byId {
+		if group.HasMember(member) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Returns a copy of all groups sorted by id, see Snapshot
+func (list *FriendGroupsList) Snapshot() []FriendGroup {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	groups := make([]FriendGroup, 0, len(list.byId))
+	for _, group := range list.byId {
+		groups = append(groups, group.copy())
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Id < groups[j].Id
+	})
+	return groups
+}
+
+// Replaces all groups
+func (list *FriendGroupsList) Restore(groups []FriendGroup) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.byId = make(map[int32]*FriendGroup, len(groups))
+	for i := range groups {
+		group := groups[i].copy()
+		list.byId[group.Id] = &group
+	}
+}
+
+// Returns a copy of the groups map
+func (list *FriendGroupsList) GetCopy() map[int32]FriendGroup {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	glist := make(map[int32]FriendGroup)
+	for key, group := range list.byId {
+		glist[key] = group.copy()
+	}
+	return glist
+}
+
+// Returns a copy of the group with the given id
+func (list *FriendGroupsList) ById(id int32) (FriendGroup, error) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	if val, ok := list.byId[id]; ok {
+		return val.copy(), nil
+	}
+	return FriendGroup{}, errors.New("Friend group not found")
+}
+
+// Returns the number of groups
+func (list *FriendGroupsList) Count() int {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return len(list.byId)
+}
+
+// A group of friends, shown as a tag in the Steam client
+type FriendGroup struct {
+	Id      int32
+	Name    string
+	Members []steamid.SteamId
+}
+
+// Whether a friend is in the group
+func (g *FriendGroup) HasMember(member steamid.SteamId) bool {
+	for _, m := range g.Members {
+		if m == member {
+			return true
+		}
+	}
+	return false
+}
+
+func (g FriendGroup) copy() FriendGroup {
+	g.Members = append([]steamid.SteamId(nil), g.Members...)
+	return g
+}
@@ -2,7 +2,9 @@ package socialcache
 
 import (
 	"errors"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/vuquang23/go-steam/protocol/steamlang"
 	"github.com/vuquang23/go-steam/steamid"
@@ -16,6 +18,11 @@ import (
 type FriendsList struct {
 	mutex sync.RWMutex
 	byId  map[steamid.SteamId]*Friend
+
+	listenersMutex        sync.RWMutex
+	onAdded               []func(Friend)
+	onRemoved             []func(Friend)
+	onRelationshipChanged []func(friend Friend, old steamlang.EFriendRelationship)
 }
 
 // Returns a new friends list
@@ -26,18 +33,110 @@ func NewFriendsList() *FriendsList {
 // Adds a friend to the friend list
 func (list *FriendsList) Add(friend Friend) {
 	list.mutex.Lock()
-	defer list.mutex.Unlock()
 	_, exists := list.byId[friend.SteamId]
 	if !exists { // make sure this doesnt already exist
 		list.byId[friend.SteamId] = &friend
 	}
+	list.mutex.Unlock()
+	if !exists {
+		list.friendAdded(friend)
+	}
 }
 
 // Removes a friend from the friend list
 func (list *FriendsList) Remove(id steamid.SteamId) {
 	list.mutex.Lock()
-	defer list.mutex.Unlock()
+	friend, exists := list.byId[id]
 	delete(list.byId, id)
+	list.mutex.Unlock()
+	if exists {
+		list.friendRemoved(*friend)
+	}
+}
+
+// Removes all friends that aren't in ids, like when the full friends list is
+// received after logging on.
+func (list *FriendsList) Retain(ids []steamid.SteamId) {
+	keep := make(map[steamid.SteamId]bool, len(ids))
+	for _, id := range ids {
+		keep[id] = true
+	}
+	var removed []Friend
+	list.mutex.Lock()
+	for id, friend := range list.byId {
+		if !keep[id] {
+			removed = append(removed, *friend)
+			delete(list.byId, id)
+		}
+	}
+	list.mutex.Unlock()
+	for _, friend := range removed {
+		list.friendRemoved(friend)
+	}
+}
+
+// Returns a copy of all friends sorted by SteamId, see Snapshot
+func (list *FriendsList) Snapshot() []Friend {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	friends := make([]Friend, 0, len(list.byId))
+	for _, friend := range list.byId {
+		friends = append(friends, *friend)
+	}
+	sort.Slice(friends, func(i, j int) bool {
+		return friends[i].SteamId < friends[j].SteamId
+	})
+	return friends
+}
+
+// Replaces all friends without calling the listeners
+func (list *FriendsList) Restore(friends []Friend) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.byId = make(map[steamid.SteamId]*Friend, len(friends))
+	for i := range friends {
+		friend := friends[i]
+		list.byId[friend.SteamId] = &friend
+	}
+}
+
+// Registers a function that is called when a friend is added to the list.
+// Listeners are called from the goroutine that handles packets and must not block.
+func (list *FriendsList) OnFriendAdded(f func(friend Friend)) {
+	list.listenersMutex.Lock()
+	defer list.listenersMutex.Unlock()
+	list.onAdded = append(list.onAdded, f)
+}
+
+// Registers a function that is called when a friend is removed from the list
+func (list *FriendsList) OnFriendRemoved(f func(friend Friend)) {
+	list.listenersMutex.Lock()
+	defer list.listenersMutex.Unlock()
+	list.onRemoved = append(list.onRemoved, f)
+}
+
+// Registers a function that is called when the relationship to a friend
+// changed, like when a friend request was accepted
+func (list *FriendsList) OnRelationshipChanged(f func(friend Friend, old steamlang.EFriendRelationship)) {
+	list.listenersMutex.Lock()
+	defer list.listenersMutex.Unlock()
+	list.onRelationshipChanged = append(list.onRelationshipChanged, f)
+}
+
+func (list *FriendsList) friendAdded(friend Friend) {
+	list.listenersMutex.RLock()
+	defer list.listenersMutex.RUnlock()
+	for _, f := range list.onAdded {
+		f(friend)
+	}
+}
+
+func (list *FriendsList) friendRemoved(friend Friend) {
+	list.listenersMutex.RLock()
+	defer list.listenersMutex.RUnlock()
+	for _, f := range list.onRemoved {
+		f(friend)
+	}
 }
 
 // Returns a copy of the friends map
@@ -87,9 +186,22 @@ func (list *FriendsList) SetAvatar(id steamid.SteamId, hash []byte) {
 
 func (list *FriendsList) SetRelationship(id steamid.SteamId, relationship steamlang.EFriendRelationship) {
 	list.mutex.Lock()
-	defer list.mutex.Unlock()
-	if val, ok := list.byId[id]; ok {
+	val, ok := list.byId[id]
+	var old steamlang.EFriendRelationship
+	var friend Friend
+	if ok {
+		old = val.Relationship
 		val.Relationship = relationship
+		friend = *val
+	}
+	list.mutex.Unlock()
+	if !ok || old == relationship {
+		return
+	}
+	list.listenersMutex.RLock()
+	defer list.listenersMutex.RUnlock()
+	for _, f := range list.onRelationshipChanged {
+		f(friend, old)
 	}
 }
 
@@ -133,6 +245,62 @@ func (list *FriendsList) SetGameName(id steamid.SteamId, name string) {
 	}
 }
 
+func (list *FriendsList) SetNickname(id steamid.SteamId, nickname string) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		val.Nickname = nickname
+	}
+}
+
+// Sets the nicknames of all friends, friends that aren't in nicknames have none
+func (list *FriendsList) SetNicknames(nicknames map[steamid.SteamId]string) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	for id, val := range list.byId {
+		val.Nickname = nicknames[id]
+	}
+}
+
+func (list *FriendsList) SetRichPresence(id steamid.SteamId, values map[string]string) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if val, ok := list.byId[id]; ok {
+		val.RichPresence = values
+	}
+}
+
+// Merges a persona state update into a friend. Only the parts of the update
+// that belong to the given flags are taken, as Steam leaves the others empty:
+//
+//	Status        PersonaState
+//	PlayerName    Name, PersonaSetByUser, NamePendingReview
+//	QueryPort     QueryPort
+//	SourceID      SourceSteamId
+//	Presence      Avatar, AvatarPendingReview, PersonaState, PersonaStateFlags,
+//	              OnlineSessionInstances, the game and the game server
+//	LastSeen      LastLogOff, LastLogOn, LastSeenOnline
+//	UserClanRank  ClanRank
+//	GameExtraInfo GameId, GameName
+//	GameDataBlob  GameDataBlob and the game
+//	ClanData      ClanTag, ClanOggAppId, ClanChatGroupId
+//	RichPresence  RichPresence
+//	Broadcast     BroadcastId
+//	Watching      the Watching fields
+//
+// Returns the friend before and after the update, ok is false if the friend isn't in the list.
+func (list *FriendsList) MergePersonaState(update Friend, flags steamlang.EClientPersonaStateFlag) (old Friend, merged Friend, ok bool) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	val, ok := list.byId[update.SteamId]
+	if !ok {
+		return Friend{}, Friend{}, false
+	}
+	old = *val
+	val.merge(&update, flags)
+	return old, *val, true
+}
+
 // A Friend
 type Friend struct {
 	SteamId           steamid.SteamId `json:",string"`
@@ -144,4 +312,116 @@ type Friend struct {
 	GameAppId         uint32
 	GameId            uint64 `json:",string"`
 	GameName          string
+	// The nickname we gave the friend, see Social.SetNickname
+	Nickname string
+
+	// Which parts of the persona state have been received, see MergePersonaState
+	Received steamlang.EClientPersonaStateFlag
+
+	PersonaSetByUser           bool
+	NamePendingReview          bool
+	AvatarPendingReview        bool
+	OnlineSessionInstances     uint32
+	SourceSteamId              steamid.SteamId `json:",string"`
+	GameServerIp               uint32
+	GameServerPort             uint32
+	QueryPort                  uint32
+	GameDataBlob               []byte
+	LastLogOff                 time.Time
+	LastLogOn                  time.Time
+	LastSeenOnline             time.Time
+	ClanRank                   uint32
+	ClanTag                    string
+	ClanOggAppId               uint32
+	ClanChatGroupId            uint64 `json:",string"`
+	RichPresence               map[string]string
+	BroadcastId                uint64 `json:",string"`
+	WatchingBroadcastAccountId uint32
+	WatchingBroadcastAppId     uint32
+	WatchingBroadcastViewers   uint32
+	WatchingBroadcastTitle     string
+}
+
+// Whether all of the flags have been received
+func (f *Friend) HasReceived(flags steamlang.EClientPersonaStateFlag) bool {
+	return f.Received&flags == flags
+}
+
+// Whether the friend is playing a game
+func (f *Friend) InGame() bool {
+	return f.GameAppId != 0 || f.GameId != 0
+}
+
+func (f *Friend) merge(u *Friend, flags steamlang.EClientPersonaStateFlag) {
+	has := func(flag steamlang.EClientPersonaStateFlag) bool {
+		return flags&flag == flag
+	}
+	if has(steamlang.EClientPersonaStateFlag_Status) {
+		f.PersonaState = u.PersonaState
+	}
+	if has(steamlang.EClientPersonaStateFlag_PlayerName) {
+		if u.Name != "" {
+			f.Name = u.Name
+		}
+		f.PersonaSetByUser = u.PersonaSetByUser
+		f.NamePendingReview = u.NamePendingReview
+	}
+	if has(steamlang.EClientPersonaStateFlag_QueryPort) {
+		f.QueryPort = u.QueryPort
+	}
+	if has(steamlang.EClientPersonaStateFlag_SourceID) {
+		f.SourceSteamId = u.SourceSteamId
+	}
+	if has(steamlang.EClientPersonaStateFlag_Presence) {
+		if u.Avatar != nil {
+			f.Avatar = u.Avatar
+		}
+		f.AvatarPendingReview = u.AvatarPendingReview
+		f.PersonaState = u.PersonaState
+		f.PersonaStateFlags = u.PersonaStateFlags
+		f.OnlineSessionInstances = u.OnlineSessionInstances
+		f.setGame(u)
+		f.GameServerIp = u.GameServerIp
+		f.GameServerPort = u.GameServerPort
+	}
+	if has(steamlang.EClientPersonaStateFlag_LastSeen) {
+		f.LastLogOff = u.LastLogOff
+		f.LastLogOn = u.LastLogOn
+		f.LastSeenOnline = u.LastSeenOnline
+	}
+	if has(steamlang.EClientPersonaStateFlag_UserClanRank) {
+		f.ClanRank = u.ClanRank
+	}
+	if has(steamlang.EClientPersonaStateFlag_GameExtraInfo) {
+		f.GameId = u.GameId
+		f.GameName = u.GameName
+	}
+	if has(steamlang.EClientPersonaStateFlag_GameDataBlob) {
+		f.GameDataBlob = u.GameDataBlob
+		f.setGame(u)
+	}
+	if has(steamlang.EClientPersonaStateFlag_ClanData) {
+		f.ClanTag = u.ClanTag
+		f.ClanOggAppId = u.ClanOggAppId
+		f.ClanChatGroupId = u.ClanChatGroupId
+	}
+	if has(steamlang.EClientPersonaStateFlag_RichPresence) {
+		f.RichPresence = u.RichPresence
+	}
+	if has(steamlang.EClientPersonaStateFlag_Broadcast) {
+		f.BroadcastId = u.BroadcastId
+	}
+	if has(steamlang.EClientPersonaStateFlag_Watching) {
+		f.WatchingBroadcastAccountId = u.WatchingBroadcastAccountId
+		f.WatchingBroadcastAppId = u.WatchingBroadcastAppId
+		f.WatchingBroadcastViewers = u.WatchingBroadcastViewers
+		f.WatchingBroadcastTitle = u.WatchingBroadcastTitle
+	}
+	f.Received |= flags
+}
+
+func (f *Friend) setGame(u *Friend) {
+	f.GameAppId = u.GameAppId
+	f.GameId = u.GameId
+	f.GameName = u.GameName
 }
@@ -2,6 +2,7 @@ package socialcache
 
 import (
 	"errors"
+	"sort"
 	"sync"
 
 	"github.com/vuquang23/go-steam/protocol/steamlang"
@@ -16,6 +17,11 @@ import (
 type GroupsList struct {
 	mutex sync.RWMutex
 	byId  map[steamid.SteamId]*Group
+
+	listenersMutex        sync.RWMutex
+	onAdded               []func(Group)
+	onRemoved             []func(Group)
+	onRelationshipChanged []func(group Group, old steamlang.EClanRelationship)
 }
 
 // Returns a new groups list
@@ -26,18 +32,109 @@ func NewGroupsList() *GroupsList {
 // Adds a group to the group list
 func (list *GroupsList) Add(group Group) {
 	list.mutex.Lock()
-	defer list.mutex.Unlock()
 	_, exists := list.byId[group.SteamId]
 	if !exists { // make sure this doesnt already exist
 		list.byId[group.SteamId] = &group
 	}
+	list.mutex.Unlock()
+	if !exists {
+		list.groupAdded(group)
+	}
 }
 
 // Removes a group from the group list
 func (list *GroupsList) Remove(id steamid.SteamId) {
 	list.mutex.Lock()
-	defer list.mutex.Unlock()
+	group, exists := list.byId[id]
 	delete(list.byId, id)
+	list.mutex.Unlock()
+	if exists {
+		list.groupRemoved(*group)
+	}
+}
+
+// Removes all groups that aren't in ids
+func (list *GroupsList) Retain(ids []steamid.SteamId) {
+	keep := make(map[steamid.SteamId]bool, len(ids))
+	for _, id := range ids {
+		keep[id.ChatToClan()] = true
+	}
+	var removed []Group
+	list.mutex.Lock()
+	for id, group := range list.byId {
+		if !keep[id] {
+			removed = append(removed, *group)
+			delete(list.byId, id)
+		}
+	}
+	list.mutex.Unlock()
+	for _, group := range removed {
+		list.groupRemoved(group)
+	}
+}
+
+// Returns a copy of all groups sorted by SteamId, see Snapshot
+func (list *GroupsList) Snapshot() []Group {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	groups := make([]Group, 0, len(list.byId))
+	for _, group := range list.byId {
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].SteamId < groups[j].SteamId
+	})
+	return groups
+}
+
+// Replaces all groups without calling the listeners
+func (list *GroupsList) Restore(groups []Group) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.byId = make(map[steamid.SteamId]*Group, len(groups))
+	for i := range groups {
+		group := groups[i]
+		list.byId[group.SteamId] = &group
+	}
+}
+
+// Registers a function that is called when a group is added to the list.
+// Listeners are called from the goroutine that handles packets and must not block.
+func (list *GroupsList) OnGroupAdded(f func(group Group)) {
+	list.listenersMutex.Lock()
+	defer list.listenersMutex.Unlock()
+	list.onAdded = append(list.onAdded, f)
+}
+
+// Registers a function that is called when a group is removed from the list
+func (list *GroupsList) OnGroupRemoved(f func(group Group)) {
+	list.listenersMutex.Lock()
+	defer list.listenersMutex.Unlock()
+	list.onRemoved = append(list.onRemoved, f)
+}
+
+// Registers a function that is called when the relationship to a group
+// changed, like when an invite was accepted
+func (list *GroupsList) OnRelationshipChanged(f func(group Group, old steamlang.EClanRelationship)) {
+	list.listenersMutex.Lock()
+	defer list.listenersMutex.Unlock()
+	list.onRelationshipChanged = append(list.onRelationshipChanged, f)
+}
+
+func (list *GroupsList) groupAdded(group Group) {
+	list.listenersMutex.RLock()
+	defer list.listenersMutex.RUnlock()
+	for _, f := range list.onAdded {
+		f(group)
+	}
+}
+
+func (list *GroupsList) groupRemoved(group Group) {
+	list.listenersMutex.RLock()
+	defer list.listenersMutex.RUnlock()
+	for _, f := range list.onRemoved {
+		f(group)
+	}
 }
 
 // Returns a copy of the groups map
@@ -90,10 +187,23 @@ func (list *GroupsList) SetAvatar(id steamid.SteamId, hash []byte) {
 
 func (list *GroupsList) SetRelationship(id steamid.SteamId, relationship steamlang.EClanRelationship) {
 	list.mutex.Lock()
-	defer list.mutex.Unlock()
 	id = id.ChatToClan()
-	if val, ok := list.byId[id]; ok {
+	val, ok := list.byId[id]
+	var old steamlang.EClanRelationship
+	var group Group
+	if ok {
+		old = val.Relationship
 		val.Relationship = relationship
+		group = *val
+	}
+	list.mutex.Unlock()
+	if !ok || old == relationship {
+		return
+	}
+	list.listenersMutex.RLock()
+	defer list.listenersMutex.RUnlock()
+	for _, f := range list.onRelationshipChanged {
+		f(group, old)
 	}
 }
 
@@ -0,0 +1,77 @@
+package socialcache
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Clan officers list is a thread safe map of the officers of clans that were
+// looked up, which don't have to be our groups.
+type ClanOfficersList struct {
+	mutex  sync.RWMutex
+	byClan map[steamid.SteamId]map[steamid.SteamId]ClanOfficer
+}
+
+// Returns a new clan officers list
+func NewClanOfficersList() *ClanOfficersList {
+	return &ClanOfficersList{byClan: make(map[steamid.SteamId]map[steamid.SteamId]ClanOfficer)}
+}
+
+// Adds an officer to a clan or replaces it
+func (list *ClanOfficersList) Add(clan steamid.SteamId, officer ClanOfficer) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	clan = clan.ChatToClan()
+	officers, ok := list.byClan[clan]
+	if !ok {
+		officers = make(map[steamid.SteamId]ClanOfficer)
+		list.byClan[clan] = officers
+	}
+	officers[officer.SteamId] = officer
+}
+
+// Replaces the officers of a clan
+func (list *ClanOfficersList) Set(clan steamid.SteamId, officers []ClanOfficer) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	byId := make(map[steamid.SteamId]ClanOfficer, len(officers))
+	for _, officer := range officers {
+		byId[officer.SteamId] = officer
+	}
+	list.byClan[clan.ChatToClan()] = byId
+}
+
+// Removes all officers of a clan
+func (list *ClanOfficersList) Clear(clan steamid.SteamId) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	delete(list.byClan, clan.ChatToClan())
+}
+
+// Returns the officers of a clan sorted by rank, which is empty if it wasn't looked up
+func (list *ClanOfficersList) ByClan(clan steamid.SteamId) []ClanOfficer {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	officers := list.byClan[clan.ChatToClan()]
+	olist := make([]ClanOfficer, 0, len(officers))
+	for _, officer := range officers {
+		olist = append(olist, officer)
+	}
+	sort.Slice(olist, func(i, j int) bool {
+		if olist[i].Rank != olist[j].Rank {
+			return olist[i].Rank < olist[j].Rank
+		}
+		return olist[i].SteamId < olist[j].SteamId
+	})
+	return olist
+}
+
+// An officer of a clan
+type ClanOfficer struct {
+	SteamId steamid.SteamId `json:",string"`
+	Name    string
+	Rank    steamlang.EClanRank
+}
@@ -0,0 +1,106 @@
+package socialcache
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// Profiles list is a thread safe map of what was looked up about users that
+// aren't necessarily our friends, like their Steam level and previous names.
+type ProfilesList struct {
+	mutex sync.RWMutex
+	byId  map[steamid.SteamId]*Profile
+}
+
+// Returns a new profiles list
+func NewProfilesList() *ProfilesList {
+	return &ProfilesList{byId: make(map[steamid.SteamId]*Profile)}
+}
+
+func (list *ProfilesList) get(id steamid.SteamId) *Profile {
+	val, ok := list.byId[id]
+	if !ok {
+		val = &Profile{SteamId: id}
+		list.byId[id] = val
+	}
+	return val
+}
+
+// Sets the Steam level of a user, adding them to the list if needed
+func (list *ProfilesList) SetLevel(id steamid.SteamId, level uint32) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	val := list.get(id)
+	val.Level = level
+	val.LevelTime = time.Now()
+}
+
+// Sets the previous names of a user, adding them to the list if needed
+func (list *ProfilesList) SetNameHistory(id steamid.SteamId, names []PersonaName) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	val := list.get(id)
+	val.NameHistory = append([]PersonaName(nil), names...)
+	val.NameHistoryTime = time.Now()
+}
+
+// Removes a user from the list
+func (list *ProfilesList) Remove(id steamid.SteamId) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	delete(list.byId, id)
+}
+
+// Returns a copy of the profiles map
+func (list *ProfilesList) GetCopy() map[steamid.SteamId]Profile {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	plist := make(map[steamid.SteamId]Profile)
+	for key, profile := range list.byId {
+		plist[key] = profile.copy()
+	}
+	return plist
+}
+
+// Returns a copy of the profile of a given SteamId
+func (list *ProfilesList) ById(id steamid.SteamId) (Profile, error) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	if val, ok := list.byId[id]; ok {
+		return val.copy(), nil
+	}
+	return Profile{}, errors.New("Profile not found")
+}
+
+// Returns the number of profiles
+func (list *ProfilesList) Count() int {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return len(list.byId)
+}
+
+// What was looked up about a user
+type Profile struct {
+	SteamId steamid.SteamId `json:",string"`
+	Level   uint32
+	// When the level was received, zero if it wasn't looked up
+	LevelTime time.Time
+	// The previous names with the time they were taken
+	NameHistory []PersonaName
+	// When the name history was received, zero if it wasn't looked up
+	NameHistoryTime time.Time
+}
+
+func (p Profile) copy() Profile {
+	p.NameHistory = append([]PersonaName(nil), p.NameHistory...)
+	return p
+}
+
+// A name that a user had
+type PersonaName struct {
+	Name  string
+	Since time.Time
+}
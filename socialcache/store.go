@@ -0,0 +1,109 @@
+package socialcache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+// The friends, groups and chats of an account at some point in time.
+type Snapshot struct {
+	SteamId      steamid.SteamId `json:",string"`
+	Time         time.Time
+	Friends      []Friend
+	Groups       []Group
+	FriendGroups []FriendGroup
+	Chats        []Chat
+}
+
+// Store keeps snapshots of the social cache, so that names and avatars of
+// friends are known right after logging on and survive restarts. Implement it
+// to keep them in a database.
+type Store interface {
+	// Returns nil and no error if nothing was saved for the account.
+	Load(steamId steamid.SteamId) (*Snapshot, error)
+	Save(snapshot *Snapshot) error
+}
+
+type memoryStore struct {
+	mutex     sync.RWMutex
+	snapshots map[steamid.SteamId]*Snapshot
+}
+
+// Returns a store that keeps snapshots for the lifetime of the process.
+func NewMemoryStore() Store {
+	return &memoryStore{snapshots: make(map[steamid.SteamId]*Snapshot)}
+}
+
+func (s *memoryStore) Load(steamId steamid.SteamId) (*Snapshot, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.snapshots[steamId], nil
+}
+
+func (s *memoryStore) Save(snapshot *Snapshot) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.snapshots[snapshot.SteamId] = snapshot
+	return nil
+}
+
+type fileStore struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+// Returns a store that writes a JSON file per account into dir, which is
+// created if it doesn't exist. Files are replaced atomically.
+func NewFileStore(dir string) Store {
+	return &fileStore{dir: dir}
+}
+
+func (s *fileStore) path(steamId steamid.SteamId) string {
+	return filepath.Join(s.dir, steamId.ToString()+".json")
+}
+
+func (s *fileStore) Load(steamId steamid.SteamId) (*Snapshot, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	data, err := os.ReadFile(s.path(steamId))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	snapshot := new(Snapshot)
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func (s *fileStore) Save(snapshot *Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err = os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, ".snapshot-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(snapshot.SteamId))
+}
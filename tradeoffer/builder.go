@@ -0,0 +1,290 @@
+package tradeoffer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vuquang23/go-steam/economy/inventory"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+var (
+	ErrNoPartner  = errors.New("trade offer has no partner")
+	ErrEmptyOffer = errors.New("trade offer has no items")
+)
+
+// OfferBuilder collects the parts of a new trade offer and validates them
+// against both inventories before anything is sent.
+//
+//	id, err := client.NewOffer().
+//		ToTradeURL(url).
+//		Give(myItem).
+//		Receive(theirItem).
+//		Message("thanks!").
+//		Send()
+//
+// Errors from the setters are kept and returned by Validate and Send.
+type OfferBuilder struct {
+	client *Client
+
+	partner          steamid.SteamId
+	accessToken      *string
+	counteredOfferId *uint64
+	message          string
+	myItems          []TradeItem
+	theirItems       []TradeItem
+	allowEscrow      bool
+	allowUnvalidated bool
+
+	err error
+}
+
+func (c *Client) NewOffer() *OfferBuilder {
+	return &OfferBuilder{client: c}
+}
+
+func (b *OfferBuilder) To(partner steamid.SteamId) *OfferBuilder {
+	b.partner = partner
+	return b
+}
+
+// Sets partner and access token from a trade URL.
+func (b *OfferBuilder) ToTradeURL(tradeURL string) *OfferBuilder {
+	u, err := ParseTradeURL(tradeURL)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	b.partner = u.Partner
+	b.accessToken = u.AccessToken()
+	return b
+}
+
+func (b *OfferBuilder) WithAccessToken(token string) *OfferBuilder {
+	b.accessToken = &token
+	return b
+}
+
+// Makes the offer a counter offer to the given received offer.
+func (b *OfferBuilder) Counter(offerId uint64) *OfferBuilder {
+	b.counteredOfferId = &offerId
+	return b
+}
+
+func (b *OfferBuilder) Message(message string) *OfferBuilder {
+	b.message = message
+	return b
+}
+
+func (b *OfferBuilder) Give(items ...TradeItem) *OfferBuilder {
+	b.myItems = append(b.myItems, items...)
+	return b
+}
+
+func (b *OfferBuilder) Receive(items ...TradeItem) *OfferBuilder {
+	b.theirItems = append(b.theirItems, items...)
+	return b
+}
+
+// By default offers that would be held in escrow are refused.
+func (b *OfferBuilder) AllowEscrow(allow bool) *OfferBuilder {
+	b.allowEscrow = allow
+	return b
+}
+
+// Steam doesn't list the balance of currencies, so their amounts can't be
+// checked and are reported as ItemProblemAmountUnvalidated. Allow this to
+// send such offers anyway.
+func (b *OfferBuilder) AllowUnvalidated(allow bool) *OfferBuilder {
+	b.allowUnvalidated = allow
+	return b
+}
+
+// Checks our items against our inventory, their items against the partner's
+// inventory and the escrow duration. Problems with items and escrow are
+// reported as a single *ValidationError.
+func (b *OfferBuilder) Validate() error {
+	if b.err != nil {
+		return b.err
+	}
+	if b.partner == 0 {
+		return ErrNoPartner
+	}
+	if len(b.myItems) == 0 && len(b.theirItems) == 0 {
+		return ErrEmptyOffer
+	}
+
+	verr := new(ValidationError)
+
+	problems, err := b.checkItems(OfferSideMine, b.myItems, func(appId uint32, contextId uint64) (*inventory.Inventory, error) {
+		return b.client.GetOwnInventory(contextId, appId, false)
+	})
+	if err != nil {
+		return err
+	}
+	verr.Items = append(verr.Items, problems...)
+
+	problems, err = b.checkItems(OfferSideTheirs, b.theirItems, func(appId uint32, contextId uint64) (*inventory.Inventory, error) {
+		return b.client.GetPartnerInventory(b.partner, contextId, appId, b.counteredOfferId)
+	})
+	if err != nil {
+		return err
+	}
+	verr.Items = append(verr.Items, problems...)
+
+	if !b.allowEscrow {
+		var escrow *EscrowDuration
+		if b.counteredOfferId != nil {
+			escrow, err = b.client.GetOfferEscrowDuration(*b.counteredOfferId)
+		} else {
+			escrow, err = b.client.GetPartnerEscrowDuration(b.partner, b.accessToken)
+		}
+		if err != nil {
+			return err
+		}
+		if escrow.DaysMyEscrow > 0 || escrow.DaysTheirEscrow > 0 {
+			verr.Escrow = escrow
+		}
+	}
+
+	if len(verr.Items) > 0 || verr.Escrow != nil {
+		return verr
+	}
+	return nil
+}
+
+// Validates the offer and sends it. On success returns the trade offer id.
+func (b *OfferBuilder) Send() (uint64, error) {
+	if err := b.Validate(); err != nil {
+		return 0, err
+	}
+	return b.client.Create(b.partner, b.accessToken, b.myItems, b.theirItems, b.counteredOfferId, b.message)
+}
+
+type inventoryKey struct {
+	appId     uint32
+	contextId uint64
+}
+
+func (b *OfferBuilder) checkItems(side OfferSide, items []TradeItem, load func(appId uint32, contextId uint64) (*inventory.Inventory, error)) ([]*ItemProblem, error) {
+	inventories := make(map[inventoryKey]*inventory.Inventory)
+	var problems []*ItemProblem
+	for _, item := range items {
+		key := inventoryKey{item.AppId, item.ContextId}
+		inv, ok := inventories[key]
+		if !ok {
+			var err error
+			inv, err = load(item.AppId, item.ContextId)
+			if err != nil {
+				return nil, err
+			}
+			inventories[key] = inv
+		}
+		reason := checkItem(inv, item)
+		if reason == ItemProblemAmountUnvalidated && b.allowUnvalidated {
+			continue
+		}
+		if reason != ItemProblemNone {
+			problems = append(problems, &ItemProblem{side, item, reason})
+		}
+	}
+	return problems, nil
+}
+
+func checkItem(inv *inventory.Inventory, item TradeItem) ItemProblemReason {
+	if item.AssetId == 0 && item.CurrencyId != 0 {
+		for _, currency := range inv.Currencies {
+			if currency.Id != item.CurrencyId {
+				continue
+			}
+			if desc, err := inv.Descriptions.Get(currency.ClassId, 0); err == nil && !desc.Tradable {
+				return ItemProblemNotTradable
+			}
+			// the balance of currencies is not listed
+			return ItemProblemAmountUnvalidated
+		}
+		return ItemProblemMissing
+	}
+
+	invItem, err := inv.Items.Get(item.AssetId)
+	if err != nil {
+		return ItemProblemMissing
+	}
+	if item.Amount > invItem.Amount {
+		return ItemProblemInsufficientAmount
+	}
+	if desc, err := inv.Descriptions.Get(invItem.ClassId, invItem.InstanceId); err == nil && !desc.Tradable {
+		return ItemProblemNotTradable
+	}
+	return ItemProblemNone
+}
+
+type OfferSide uint
+
+const (
+	OfferSideMine OfferSide = iota
+	OfferSideTheirs
+)
+
+func (s OfferSide) String() string {
+	if s == OfferSideMine {
+		return "mine"
+	}
+	return "theirs"
+}
+
+type ItemProblemReason uint
+
+const (
+	ItemProblemNone ItemProblemReason = iota
+	// The item is not in the inventory, or not visible to us.
+	ItemProblemMissing
+	ItemProblemNotTradable
+	// The requested amount of a stackable item is larger than the stack.
+	ItemProblemInsufficientAmount
+	// The item is a currency, whose balance Steam doesn't list.
+	// See OfferBuilder.AllowUnvalidated.
+	ItemProblemAmountUnvalidated
+)
+
+func (r ItemProblemReason) String() string {
+	switch r {
+	case ItemProblemNone:
+		return "none"
+	case ItemProblemMissing:
+		return "missing"
+	case ItemProblemNotTradable:
+		return "not tradable"
+	case ItemProblemInsufficientAmount:
+		return "insufficient amount"
+	case ItemProblemAmountUnvalidated:
+		return "amount unvalidated"
+	}
+	return fmt.Sprintf("ItemProblemReason(%d)", uint(r))
+}
+
+type ItemProblem struct {
+	Side   OfferSide
+	Item   TradeItem
+	Reason ItemProblemReason
+}
+
+// ValidationError is returned by OfferBuilder.Validate and OfferBuilder.Send
+// when the offer would fail or be held. Nothing was sent to Steam.
+type ValidationError struct {
+	Items []*ItemProblem
+	// Set if the offer would be held in escrow and escrow isn't allowed.
+	Escrow *EscrowDuration
+}
+
+func (e *ValidationError) Error() string {
+	var parts []string
+	for _, p := range e.Items {
+		parts = append(parts, fmt.Sprintf("%s item %d/%d/%d: %v", p.Side, p.Item.AppId, p.Item.ContextId, p.Item.AssetId, p.Reason))
+	}
+	if e.Escrow != nil {
+		parts = append(parts, fmt.Sprintf("escrow: %d days mine, %d days theirs", e.Escrow.DaysMyEscrow, e.Escrow.DaysTheirEscrow))
+	}
+	return "invalid trade offer: " + strings.Join(parts, "; ")
+}
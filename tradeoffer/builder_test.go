@@ -0,0 +1,54 @@
+package tradeoffer
+
+import (
+	"testing"
+
+	"github.com/vuquang23/go-steam/economy/inventory"
+)
+
+func TestCheckItems(t *testing.T) {
+	inv := &inventory.Inventory{
+		Items: inventory.Items{
+			"1": {Id: 1, ClassId: 10, Amount: 1},
+			"2": {Id: 2, ClassId: 20, Amount: 5},
+		},
+		Currencies: inventory.Currencies{
+			"3": {Id: 3, ClassId: 30, IsCurrency: true},
+		},
+		Descriptions: inventory.Descriptions{
+			"10_0": {ClassId: 10, Tradable: true},
+			"20_0": {ClassId: 20, Tradable: false},
+			"30_0": {ClassId: 30, Tradable: true},
+		},
+	}
+	load := func(appId uint32, contextId uint64) (*inventory.Inventory, error) {
+		return inv, nil
+	}
+
+	for _, test := range []struct {
+		item             TradeItem
+		allowUnvalidated bool
+		reason           ItemProblemReason
+	}{
+		{TradeItem{AssetId: 1, Amount: 1}, false, ItemProblemNone},
+		{TradeItem{AssetId: 4, Amount: 1}, false, ItemProblemMissing},
+		{TradeItem{AssetId: 2, Amount: 6}, false, ItemProblemInsufficientAmount},
+		{TradeItem{AssetId: 2, Amount: 1}, false, ItemProblemNotTradable},
+		{TradeItem{CurrencyId: 3, Amount: 100}, false, ItemProblemAmountUnvalidated},
+		{TradeItem{CurrencyId: 3, Amount: 100}, true, ItemProblemNone},
+		{TradeItem{CurrencyId: 5, Amount: 100}, true, ItemProblemMissing},
+	} {
+		b := &OfferBuilder{allowUnvalidated: test.allowUnvalidated}
+		problems, err := b.checkItems(OfferSideMine, []TradeItem{test.item}, load)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reason := ItemProblemNone
+		if len(problems) > 0 {
+			reason = problems[0].Reason
+		}
+		if reason != test.reason {
+			t.Errorf("Expected %v for %+v, got %v", test.reason, test.item, reason)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/vuquang23/go-steam/community"
 	"github.com/vuquang23/go-steam/economy/inventory"
 	"github.com/vuquang23/go-steam/netutil"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
 	"github.com/vuquang23/go-steam/steamid"
 )
 
@@ -23,20 +24,35 @@ const apiUrl = "https://api.steampowered.com/IEconService/%s/v%d"
 const defaultUserAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/51.0.2704.103 Safari/537.36"
 
 type Client struct {
-	client    *http.Client
-	key       APIKey
-	sessionId string
+	client       *http.Client
+	key          APIKey
+	sessionId    string
+	descriptions *DescriptionCache
+	resolver     *inventory.DescriptionResolver
 }
 
 func NewClient(key APIKey, sessionId string) *Client {
+	httpClient := new(http.Client)
 	c := &Client{
-		new(http.Client),
+		httpClient,
 		key,
 		sessionId,
+		NewDescriptionCache(defaultDescriptionCacheSize),
+		inventory.NewDescriptionResolver(httpClient, string(key), "english"),
 	}
 	return c
 }
 
+// Replaces the cache used to attach descriptions to offer assets,
+// for example to share it between several clients.
+func (c *Client) SetDescriptionCache(cache *DescriptionCache) {
+	c.descriptions = cache
+}
+
+func (c *Client) DescriptionCache() *DescriptionCache {
+	return c.descriptions
+}
+
 func (c *Client) SetProxy(proxy string) error {
 	proxyUrl, err := url.Parse(proxy)
 	if err != nil {
@@ -74,7 +90,7 @@ func (c *Client) GetOffer(offerId uint64) (*TradeOfferResult, error) {
 	if t.Response == nil || t.Response.Offer == nil {
 		return nil, newSteamErrorf("steam returned empty offer result\n")
 	}
-
+	t.Response.AttachDescriptions(c.descriptions)
 	return t.Response, nil
 }
 
@@ -128,9 +144,69 @@ func (c *Client) GetOffers(getSent bool, getReceived bool, getDescriptions bool,
 	if t.Response == nil {
 		return nil, newSteamErrorf("steam returned empty offers result\n")
 	}
+	t.Response.AttachDescriptions(c.descriptions)
 	return t.Response, nil
 }
 
+type TradeOffersSummary struct {
+	PendingReceivedCount    uint32 `json:"pending_received_count"`
+	NewReceivedCount        uint32 `json:"new_received_count"`
+	UpdatedReceivedCount    uint32 `json:"updated_received_count"`
+	HistoricalReceivedCount uint32 `json:"historical_received_count"`
+	PendingSentCount        uint32 `json:"pending_sent_count"`
+	NewlyAcceptedSentCount  uint32 `json:"newly_accepted_sent_count"`
+	UpdatedSentCount        uint32 `json:"updated_sent_count"`
+	HistoricalSentCount     uint32 `json:"historical_sent_count"`
+	EscrowReceivedCount     uint32 `json:"escrow_received_count"`
+	EscrowSentCount         uint32 `json:"escrow_sent_count"`
+}
+
+// Gets offer counts. New and updated counts are relative to timeLastVisit,
+// pass nil to use the time Steam last saw us on the offers page.
+func (c *Client) GetOffersSummary(timeLastVisit *uint32) (*TradeOffersSummary, error) {
+	params := map[string]string{}
+	if timeLastVisit != nil {
+		params["time_last_visit"] = strconv.FormatUint(uint64(*timeLastVisit), 10)
+	}
+	res := new(TradeOffersSummary)
+	if err := c.apiGet("GetTradeOffersSummary", 1, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// apiGet calls a GET method of IEconService and decodes the `response` object into v.
+func (c *Client) apiGet(method string, version uint, params map[string]string, v interface{}) error {
+	params["key"] = string(c.key)
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf(apiUrl, method, version)+"?"+netutil.ToUrlValues(params).Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", defaultUserAgent)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return fmt.Errorf(method+" error: status code %d", resp.StatusCode)
+	}
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	t := struct {
+		Response json.RawMessage
+	}{}
+	if err = json.Unmarshal(bodyBytes, &t); err != nil {
+		return fmt.Errorf("unmarshal error: %s, response: %s", err, bodyBytes)
+	}
+	if len(t.Response) == 0 || string(t.Response) == "null" {
+		return newSteamErrorf("steam returned empty %s result\n", method)
+	}
+	return json.Unmarshal(t.Response, v)
+}
+
 // action() is used by Decline() and Cancel()
 // Steam only return success and error fields for malformed requests,
 // hence client shall use GetOffer() to check action result
@@ -284,7 +360,7 @@ func (c *Client) Create(other steamid.SteamId, accessToken *string, myItems, the
 	// 20	wrong contextid
 	// 25	can't send more offers until some is accepted/cancelled...
 	// 26	object is not in our inventory
-	// error code names are in internal/steamlang/enums.go EResult_name
+	// the code is parsed into SteamError.Result, see error.go for categories
 	if t.StrError != "" {
 		return 0, newSteamErrorf("create error: %v\n", t.StrError)
 	}
@@ -301,6 +377,11 @@ func (c *Client) GetOwnInventory(contextId uint64, appId uint32, tradableOnly bo
 	return inventory.GetOwnInventory(c.client, contextId, appId, tradableOnly)
 }
 
+// Gets the inventory of any user through the /inventory endpoint, options may be nil.
+func (c *Client) GetInventory(steamId steamid.SteamId, contextId uint64, appId uint32, options *inventory.InventoryOptions) (*inventory.Inventory, error) {
+	return inventory.GetInventory(c.client, steamId, appId, contextId, options)
+}
+
 func (c *Client) GetPartnerInventory(other steamid.SteamId, contextId uint64, appId uint32, offerId *uint64) (*inventory.Inventory, error) {
 	return inventory.GetFullInventory(func() (*inventory.PartialInventory, error) {
 		return c.getPartialPartnerInventory(other, contextId, appId, offerId, nil)
@@ -417,6 +498,15 @@ func (c *Client) GetOffersWithRetry(getSent bool, getReceived bool, getDescripti
 		}, retryCount, retryDelay)
 }
 
+func (c *Client) GetOffersSummaryWithRetry(timeLastVisit *uint32, retryCount int, retryDelay time.Duration) (*TradeOffersSummary, error) {
+	var res *TradeOffersSummary
+	return res, withRetry(
+		func() (err error) {
+			res, err = c.GetOffersSummary(timeLastVisit)
+			return err
+		}, retryCount, retryDelay)
+}
+
 func (c *Client) DeclineWithRetry(offerId uint64, retryCount int, retryDelay time.Duration) error {
 	return withRetry(
 		func() error {
@@ -431,19 +521,41 @@ func (c *Client) CancelWithRetry(offerId uint64, retryCount int, retryDelay time
 		}, retryCount, retryDelay)
 }
 
+// AcceptWithRetry doesn't retry Fail and Timeout, as Steam often returns them
+// for offers that were accepted anyway.
 func (c *Client) AcceptWithRetry(offerId uint64, retryCount int, retryDelay time.Duration) error {
-	return withRetry(
+	return retry(
 		func() error {
 			return c.Accept(offerId)
-		}, retryCount, retryDelay)
+		}, isRetryableOnce, retryCount, retryDelay)
 }
 
+// CreateWithRetry doesn't retry Fail and Timeout, as Steam often returns them
+// for offers that were created anyway. Check GetOffers before sending again.
 func (c *Client) CreateWithRetry(other steamid.SteamId, accessToken *string, myItems, theirItems []TradeItem, counteredOfferId *uint64, message string, retryCount int, retryDelay time.Duration) (uint64, error) {
 	var res uint64
-	return res, withRetry(
+	return res, retry(
 		func() (err error) {
 			res, err = c.Create(other, accessToken, myItems, theirItems, counteredOfferId, message)
 			return err
+		}, isRetryableOnce, retryCount, retryDelay)
+}
+
+func (c *Client) GetTradeHistoryWithRetry(options TradeHistoryOptions, retryCount int, retryDelay time.Duration) (*TradeHistoryResult, error) {
+	var res *TradeHistoryResult
+	return res, withRetry(
+		func() (err error) {
+			res, err = c.GetTradeHistory(options)
+			return err
+		}, retryCount, retryDelay)
+}
+
+func (c *Client) GetTradeStatusWithRetry(tradeId uint64, getDescriptions bool, retryCount int, retryDelay time.Duration) (*TradeStatusResult, error) {
+	var res *TradeStatusResult
+	return res, withRetry(
+		func() (err error) {
+			res, err = c.GetTradeStatus(tradeId, getDescriptions)
+			return err
 		}, retryCount, retryDelay)
 }
 
@@ -474,25 +586,49 @@ func (c *Client) GetTradeReceiptWithRetry(tradeId uint64, retryCount int, retryD
 		}, retryCount, retryDelay)
 }
 
+// withRetry retries f on network errors and on Steam errors that are
+// retryable or rate limited. Rate limited requests wait twice as long with
+// every attempt. Other Steam errors are returned immediately.
 func withRetry(f func() error, retryCount int, retryDelay time.Duration) error {
+	return retry(f, isRetryable, retryCount, retryDelay)
+}
+
+func isRetryable(err *SteamError) bool {
+	return err.Category == ErrorCategoryRetryable || err.Category == ErrorCategoryRateLimited
+}
+
+// For requests that must not be sent twice: Fail and Timeout don't tell
+// whether Steam did what we asked, so they aren't retried.
+func isRetryableOnce(err *SteamError) bool {
+	switch err.Result {
+	case steamlang.EResult_Fail, steamlang.EResult_Timeout:
+		return false
+	}
+	return isRetryable(err)
+}
+
+func retry(f func() error, retryable func(*SteamError) bool, retryCount int, retryDelay time.Duration) error {
 	if retryCount <= 0 {
 		panic("retry count must be more than 0")
 	}
-	i := 0
-	for {
-		i++
-		if err := f(); err != nil {
-			// If we got steam error do not retry
-			if _, ok := err.(*SteamError); ok {
+	delay := retryDelay
+	for i := 1; ; i++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if i == retryCount {
+			return err
+		}
+		var steamErr *SteamError
+		if errors.As(err, &steamErr) {
+			if !retryable(steamErr) {
 				return err
 			}
-			if i == retryCount {
-				return err
+			if steamErr.Category == ErrorCategoryRateLimited {
+				delay *= 2
 			}
-			time.Sleep(retryDelay)
-			continue
 		}
-		break
+		time.Sleep(delay)
 	}
-	return nil
 }
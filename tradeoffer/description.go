@@ -0,0 +1,197 @@
+package tradeoffer
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/vuquang23/go-steam/economy/inventory"
+)
+
+type descriptionKey struct {
+	appId      uint32
+	classId    uint64
+	instanceId uint64
+}
+
+// Used by NewClient
+const defaultDescriptionCacheSize = 10000
+
+// DescriptionCache keeps one Description per app, class and instance, so that
+// offers from repeated polls share their descriptions instead of holding copies.
+// Class ids are only unique within an app, so the app is part of the key.
+// It is safe for concurrent use.
+//
+// When the cache is full, the least recently used description is evicted.
+//
+// Every Client has its own cache of 10000 descriptions, which GetOffer and
+// GetOffers use to fill in Asset.Description. Use SetDescriptionCache to share
+// one between clients or to change its size.
+type DescriptionCache struct {
+	size int
+
+	mutex sync.Mutex
+	// elements hold a *Description, the most recently used one at the front
+	order        *list.List
+	descriptions map[descriptionKey]*list.Element
+}
+
+// Creates a cache that holds up to size descriptions. With a size of 0 or less
+// it is never evicted and grows with every class seen, so long running programs
+// should call Clear now and then.
+func NewDescriptionCache(size int) *DescriptionCache {
+	return &DescriptionCache{
+		size:         size,
+		order:        list.New(),
+		descriptions: make(map[descriptionKey]*list.Element),
+	}
+}
+
+// Adds descriptions to the cache and returns the cached instance for each of them.
+// Descriptions already in the cache are kept, as descriptions of a class and instance don't change.
+func (c *DescriptionCache) Add(descriptions ...*Description) []*Description {
+	cached := make([]*Description, len(descriptions))
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i, desc := range descriptions {
+		key := descriptionKey{desc.AppId, desc.ClassId, desc.InstanceId}
+		if e, ok := c.descriptions[key]; ok {
+			c.order.MoveToFront(e)
+			cached[i] = e.Value.(*Description)
+			continue
+		}
+		c.descriptions[key] = c.order.PushFront(desc)
+		cached[i] = desc
+		if c.size > 0 && c.order.Len() > c.size {
+			old := c.order.Remove(c.order.Back()).(*Description)
+			delete(c.descriptions, descriptionKey{old.AppId, old.ClassId, old.InstanceId})
+		}
+	}
+	return cached
+}
+
+// Returns nil if the description is not cached.
+func (c *DescriptionCache) Get(appId uint32, classId uint64, instanceId uint64) *Description {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.descriptions[descriptionKey{appId, classId, instanceId}]
+	if !ok {
+		return nil
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*Description)
+}
+
+func (c *DescriptionCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.order.Len()
+}
+
+func (c *DescriptionCache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.order.Init()
+	c.descriptions = make(map[descriptionKey]*list.Element)
+}
+
+// Sets Description of every asset in ToGive and ToReceive from the cache.
+// Returns false if any asset has no cached description.
+func (t *TradeOffer) AttachDescriptions(cache *DescriptionCache) bool {
+	complete := attachDescriptions(t.ToGive, cache)
+	return attachDescriptions(t.ToReceive, cache) && complete
+}
+
+func attachDescriptions(assets []*Asset, cache *DescriptionCache) bool {
+	complete := true
+	for _, asset := range assets {
+		asset.Description = cache.Get(asset.AppId, asset.ClassId, asset.InstanceId)
+		if asset.Description == nil {
+			complete = false
+		}
+	}
+	return complete
+}
+
+// Adds the descriptions of the result to the cache and attaches them to the assets of all offers.
+// Descriptions is replaced with the cached instances.
+func (r *TradeOffersResult) AttachDescriptions(cache *DescriptionCache) {
+	r.Descriptions = cache.Add(r.Descriptions...)
+	for _, offer := range r.Sent {
+		offer.AttachDescriptions(cache)
+	}
+	for _, offer := range r.Received {
+		offer.AttachDescriptions(cache)
+	}
+}
+
+// Adds the descriptions of the result to the cache and attaches them to the assets of the offer.
+// Descriptions is replaced with the cached instances.
+func (r *TradeOfferResult) AttachDescriptions(cache *DescriptionCache) {
+	r.Descriptions = cache.Add(r.Descriptions...)
+	if r.Offer != nil {
+		r.Offer.AttachDescriptions(cache)
+	}
+}
+
+// Returns the resolver FillDescriptions uses, which can also look up
+// descriptions for the trade history.
+func (c *Client) DescriptionResolver() *inventory.DescriptionResolver {
+	return c.resolver
+}
+
+// Looks up descriptions that didn't come with the offers through GetAssetClassInfo,
+// adds them to the description cache and attaches them to the assets.
+func (c *Client) FillDescriptions(offers ...*TradeOffer) error {
+	missing := make(map[uint32][]inventory.ClassInstance)
+	for _, offer := range offers {
+		for _, asset := range append(append([]*Asset(nil), offer.ToGive...), offer.ToReceive...) {
+			if asset.Description == nil {
+				missing[asset.AppId] = append(missing[asset.AppId], inventory.ClassInstance{ClassId: asset.ClassId, InstanceId: asset.InstanceId})
+			}
+		}
+	}
+	for appId, classes := range missing {
+		descs, err := c.resolver.Resolve(appId, classes)
+		if err != nil {
+			return err
+		}
+		for _, desc := range descs {
+			c.descriptions.Add(descriptionFromInventory(desc))
+		}
+	}
+	for _, offer := range offers {
+		offer.AttachDescriptions(c.descriptions)
+	}
+	return nil
+}
+
+func descriptionFromInventory(d *inventory.Description) *Description {
+	desc := &Description{
+		AppId:                     d.AppId,
+		ClassId:                   d.ClassId,
+		InstanceId:                d.InstanceId,
+		IconUrl:                   d.IconUrl,
+		IconUrlLarge:              d.IconUrlLarge,
+		Name:                      d.Name,
+		MarketName:                d.MarketName,
+		MarketHashName:            d.MarketHashName,
+		NameColor:                 d.NameColor,
+		BackgroundColor:           d.BackgroundColor,
+		Type:                      d.Type,
+		Tradable:                  bool(d.Tradable),
+		Marketable:                bool(d.Marketable),
+		Commodity:                 bool(d.Commodity),
+		MarketTradableRestriction: d.MarketTradableRestriction,
+		Descriptions:              d.Descriptions,
+		Actions:                   d.Actions,
+	}
+	for _, tag := range d.Tags {
+		desc.Tags = append(desc.Tags, &Tag{
+			InternalName: tag.InternalName,
+			Name:         tag.Name,
+			Category:     tag.Category,
+			CategoryName: tag.CategoryName,
+		})
+	}
+	return desc
+}
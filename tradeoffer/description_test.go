@@ -0,0 +1,29 @@
+package tradeoffer
+
+import "testing"
+
+func TestDescriptionCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewDescriptionCache(2)
+	cache.Add(&Description{AppId: 730, ClassId: 1}, &Description{AppId: 730, ClassId: 2})
+	cache.Get(730, 1, 0)
+	cache.Add(&Description{AppId: 730, ClassId: 3})
+
+	if cache.Len() != 2 {
+		t.Errorf("Expected 2 descriptions, got %d", cache.Len())
+	}
+	if cache.Get(730, 2, 0) != nil {
+		t.Errorf("Expected class 2 to be evicted")
+	}
+	if cache.Get(730, 1, 0) == nil || cache.Get(730, 3, 0) == nil {
+		t.Errorf("Expected classes 1 and 3 to be cached")
+	}
+}
+
+func TestDescriptionCacheKeepsFirstInstance(t *testing.T) {
+	cache := NewDescriptionCache(0)
+	first := &Description{AppId: 440, ClassId: 1}
+	cached := cache.Add(first, &Description{AppId: 440, ClassId: 1}, &Description{AppId: 730, ClassId: 1})
+	if cached[0] != first || cached[1] != first || cached[2] == first {
+		t.Errorf("Expected the first description of app 440 to be shared only within the app")
+	}
+}
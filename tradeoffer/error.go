@@ -1,19 +1,135 @@
 package tradeoffer
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+)
+
+type ErrorCategory uint
+
+const (
+	// Steam gave no result code we could classify.
+	ErrorCategoryUnknown ErrorCategory = iota
+	// The request may succeed when repeated.
+	ErrorCategoryRetryable
+	// Repeating the request won't help, for example because items are gone or
+	// we can't send more offers until some are accepted or cancelled.
+	ErrorCategoryPermanent
+	// The session, API key or trade access token is not valid.
+	ErrorCategoryAuth
+	// Too many requests. Retry after a while.
+	ErrorCategoryRateLimited
+)
+
+func (c ErrorCategory) String() string {
+	switch c {
+	case ErrorCategoryUnknown:
+		return "unknown"
+	case ErrorCategoryRetryable:
+		return "retryable"
+	case ErrorCategoryPermanent:
+		return "permanent"
+	case ErrorCategoryAuth:
+		return "auth"
+	case ErrorCategoryRateLimited:
+		return "rate limited"
+	}
+	return fmt.Sprintf("ErrorCategory(%d)", uint(c))
+}
+
+// Sentinels for errors.Is. A *SteamError matches the sentinel of its category
+// and, where one exists, the sentinel of its result.
+var (
+	ErrRetryable   = errors.New("retryable steam error")
+	ErrPermanent   = errors.New("permanent steam error")
+	ErrAuth        = errors.New("steam authentication error")
+	ErrRateLimited = errors.New("steam rate limit")
+
+	// 15: the trade access token is invalid or the partner can't trade with us
+	ErrInvalidAccessToken = errors.New("invalid trade access token")
+	// 25: we can't send more offers until some are accepted or cancelled
+	ErrOfferLimitExceeded = errors.New("trade offer limit exceeded")
+	// 26: an item is no longer in the inventory
+	ErrItemNotInInventory = errors.New("item is not in inventory")
 )
 
+var categorySentinels = map[ErrorCategory]error{
+	ErrorCategoryRetryable:   ErrRetryable,
+	ErrorCategoryPermanent:   ErrPermanent,
+	ErrorCategoryAuth:        ErrAuth,
+	ErrorCategoryRateLimited: ErrRateLimited,
+}
+
+var resultSentinels = map[steamlang.EResult]error{
+	steamlang.EResult_AccessDenied:  ErrInvalidAccessToken,
+	steamlang.EResult_LimitExceeded: ErrOfferLimitExceeded,
+	steamlang.EResult_Revoked:       ErrItemNotInInventory,
+}
+
 // SteamError can be returned by Create, Accept, Decline and Cancel methods.
 // It means we got response from steam, but it was in unknown format
 // or request was declined.
 type SteamError struct {
 	msg string
+	// Parsed from the trailing `(<code>)` of Steam's message, EResult_Invalid if there was none.
+	Result   steamlang.EResult
+	Category ErrorCategory
 }
 
 func (e *SteamError) Error() string {
 	return e.msg
 }
+
+func (e *SteamError) Is(target error) bool {
+	if sentinel, ok := categorySentinels[e.Category]; ok && sentinel == target {
+		return true
+	}
+	if sentinel, ok := resultSentinels[e.Result]; ok && sentinel == target {
+		return true
+	}
+	return false
+}
+
+var resultCodeRegex = regexp.MustCompile(`\((\d+)\)\s*$`)
+
 func newSteamErrorf(format string, a ...interface{}) *SteamError {
-	return &SteamError{fmt.Sprintf(format, a...)}
+	msg := fmt.Sprintf(format, a...)
+	e := &SteamError{msg: msg}
+	if m := resultCodeRegex.FindStringSubmatch(msg); m != nil {
+		if code, err := strconv.ParseInt(m[1], 10, 32); err == nil {
+			e.Result = steamlang.EResult(code)
+			e.Category = categorize(e.Result)
+		}
+	}
+	return e
+}
+
+func categorize(result steamlang.EResult) ErrorCategory {
+	switch result {
+	case steamlang.EResult_Invalid:
+		return ErrorCategoryUnknown
+	case steamlang.EResult_Fail,
+		steamlang.EResult_NoConnection,
+		steamlang.EResult_Busy,
+		steamlang.EResult_Timeout,
+		steamlang.EResult_ServiceUnavailable,
+		steamlang.EResult_ServiceReadOnly,
+		steamlang.EResult_TryAnotherCM,
+		steamlang.EResult_RemoteCallFailed:
+		return ErrorCategoryRetryable
+	case steamlang.EResult_InvalidPassword,
+		steamlang.EResult_LoggedInElsewhere,
+		steamlang.EResult_AccessDenied,
+		steamlang.EResult_NotLoggedOn,
+		steamlang.EResult_Expired:
+		return ErrorCategoryAuth
+	case steamlang.EResult_RateLimitExceeded,
+		steamlang.EResult_TooManyPending:
+		return ErrorCategoryRateLimited
+	}
+	return ErrorCategoryPermanent
 }
@@ -0,0 +1,104 @@
+package tradeoffer
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+)
+
+func TestCategorize(t *testing.T) {
+	for _, test := range []struct {
+		result   steamlang.EResult
+		category ErrorCategory
+	}{
+		{steamlang.EResult_Invalid, ErrorCategoryUnknown},
+		{steamlang.EResult_Fail, ErrorCategoryRetryable},
+		{steamlang.EResult_Busy, ErrorCategoryRetryable},
+		{steamlang.EResult_Timeout, ErrorCategoryRetryable},
+		{steamlang.EResult_ServiceUnavailable, ErrorCategoryRetryable},
+		{steamlang.EResult_AccessDenied, ErrorCategoryAuth},
+		{steamlang.EResult_NotLoggedOn, ErrorCategoryAuth},
+		{steamlang.EResult_LimitExceeded, ErrorCategoryPermanent},
+		{steamlang.EResult_AccountLimitExceeded, ErrorCategoryPermanent},
+		{steamlang.EResult_RateLimitExceeded, ErrorCategoryRateLimited},
+		{steamlang.EResult_Revoked, ErrorCategoryPermanent},
+		{steamlang.EResult_InvalidParam, ErrorCategoryPermanent},
+	} {
+		if c := categorize(test.result); c != test.category {
+			t.Errorf("Expected %v for %v, got %v", test.category, test.result, c)
+		}
+	}
+}
+
+func TestNewSteamErrorResult(t *testing.T) {
+	for _, test := range []struct {
+		msg    string
+		result steamlang.EResult
+	}{
+		{"accept error: There was an error accepting this trade offer. (16)\n", steamlang.EResult_Timeout},
+		{"create error: There was an error sending your trade offer. (26)", steamlang.EResult_Revoked},
+		{"create error: (15) is not at the end (2) ", steamlang.EResult_Fail},
+		{"steam returned empty offers result\n", steamlang.EResult_Invalid},
+		{"create error: code (abc)", steamlang.EResult_Invalid},
+	} {
+		if e := newSteamErrorf("%s", test.msg); e.Result != test.result {
+			t.Errorf("Expected %v for %q, got %v", test.result, test.msg, e.Result)
+		}
+	}
+	if err := newSteamErrorf("create error: (26)"); !errors.Is(err, ErrItemNotInInventory) || !errors.Is(err, ErrPermanent) {
+		t.Errorf("Expected %v to match its sentinels", err)
+	}
+}
+
+func TestRetryOnce(t *testing.T) {
+	for _, test := range []struct {
+		msg   string
+		calls int
+	}{
+		{"create error: (2)", 1},
+		{"create error: (16)", 1},
+		{"create error: (25)", 1},
+		{"create error: (26)", 1},
+		{"create error: (20)", 3},
+	} {
+		calls := 0
+		retry(func() error {
+			calls++
+			return newSteamErrorf("%s", test.msg)
+		}, isRetryableOnce, 3, 0)
+		if calls != test.calls {
+			t.Errorf("Expected %d calls for %q, got %d", test.calls, test.msg, calls)
+		}
+	}
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCreateWithRetryOfferLimit(t *testing.T) {
+	calls := 0
+	c := NewClient("", "session")
+	c.client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       io.NopCloser(strings.NewReader(`{"strError":"There was an error sending your trade offer. (25)"}`)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	_, err := c.CreateWithRetry(0, nil, nil, nil, nil, "", 3, 0)
+	if !errors.Is(err, ErrOfferLimitExceeded) || !errors.Is(err, ErrPermanent) {
+		t.Errorf("Expected a permanent offer limit error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
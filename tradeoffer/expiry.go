@@ -0,0 +1,183 @@
+package tradeoffer
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const defaultExpiryCheckInterval = time.Minute
+
+type ExpiryConfig struct {
+	// Sent offers older than this are cancelled. Zero disables it.
+	SentTTL time.Duration
+	// Sent offers are cancelled this long before Steam would expire them.
+	// Zero disables it.
+	CancelBeforeExpiry time.Duration
+	// OnReceivedExpiring is called once for every received offer that expires
+	// within this duration. Zero disables it.
+	WarnBeforeExpiry time.Duration
+
+	OnReceivedExpiring func(offer *TradeOffer, left time.Duration)
+	// Called after a sent offer was cancelled, err is set if that failed.
+	// Failed offers are tried again on the next check, unless Steam says
+	// retrying won't help.
+	OnCancelled func(offer *TradeOffer, err error)
+
+	// How often deadlines are checked. Defaults to one minute.
+	CheckInterval time.Duration
+}
+
+// ExpiryScheduler cancels our sent offers after a TTL or shortly before Steam
+// expires them, and warns about received offers that are about to expire.
+//
+// Offers are added with Track, or by passing the events of a Manager to HandleEvent.
+// Offers that are no longer active are dropped automatically.
+type ExpiryScheduler struct {
+	client *Client
+	config ExpiryConfig
+
+	mutex  sync.Mutex
+	offers map[uint64]*TradeOffer
+	warned map[uint64]bool
+	// offers a Check is cancelling right now
+	cancelling map[uint64]bool
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+func NewExpiryScheduler(client *Client, config ExpiryConfig) *ExpiryScheduler {
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaultExpiryCheckInterval
+	}
+	return &ExpiryScheduler{
+		client:     client,
+		config:     config,
+		offers:     make(map[uint64]*TradeOffer),
+		warned:     make(map[uint64]bool),
+		cancelling: make(map[uint64]bool),
+	}
+}
+
+// Adds or updates an offer. Inactive offers are removed instead.
+func (s *ExpiryScheduler) Track(offer *TradeOffer) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if offer.State != TradeOfferState_Active {
+		delete(s.offers, offer.TradeOfferId)
+		delete(s.warned, offer.TradeOfferId)
+		return
+	}
+	s.offers[offer.TradeOfferId] = offer
+}
+
+func (s *ExpiryScheduler) Untrack(offerId uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.offers, offerId)
+	delete(s.warned, offerId)
+}
+
+// Tracks offers from NewOfferEvent and OfferChangedEvent of a Manager.
+func (s *ExpiryScheduler) HandleEvent(event interface{}) {
+	switch e := event.(type) {
+	case *NewOfferEvent:
+		s.Track(e.Offer)
+	case *OfferChangedEvent:
+		s.Track(e.Offer)
+	}
+}
+
+// Starts checking deadlines in a new goroutine. Calling Start on a running scheduler does nothing.
+func (s *ExpiryScheduler) Start() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.stop != nil {
+		return
+	}
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.loop(s.stop, s.done)
+}
+
+// Stops checking and waits for the current check to finish.
+func (s *ExpiryScheduler) Stop() {
+	s.mutex.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mutex.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (s *ExpiryScheduler) loop(stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(s.config.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			s.Check(now)
+		}
+	}
+}
+
+// Cancels and warns about all tracked offers whose deadline has passed at now.
+// It is called periodically by Start, but may also be called directly.
+func (s *ExpiryScheduler) Check(now time.Time) {
+	var cancel, warn []*TradeOffer
+	s.mutex.Lock()
+	for id, offer := range s.offers {
+		if offer.IsOurOffer {
+			if !s.cancelling[id] && s.shouldCancel(offer, now) {
+				cancel = append(cancel, offer)
+				s.cancelling[id] = true
+			}
+		} else if !s.warned[id] && s.shouldWarn(offer, now) {
+			warn = append(warn, offer)
+			s.warned[id] = true
+		}
+	}
+	s.mutex.Unlock()
+
+	for _, offer := range cancel {
+		err := s.client.Cancel(offer.TradeOfferId)
+		s.mutex.Lock()
+		delete(s.cancelling, offer.TradeOfferId)
+		if err == nil || errors.Is(err, ErrPermanent) {
+			delete(s.offers, offer.TradeOfferId)
+		}
+		s.mutex.Unlock()
+		if s.config.OnCancelled != nil {
+			s.config.OnCancelled(offer, err)
+		}
+	}
+	for _, offer := range warn {
+		if s.config.OnReceivedExpiring != nil {
+			s.config.OnReceivedExpiring(offer, expiresAt(offer).Sub(now))
+		}
+	}
+}
+
+func (s *ExpiryScheduler) shouldCancel(offer *TradeOffer, now time.Time) bool {
+	if s.config.SentTTL > 0 && now.Sub(time.Unix(int64(offer.TimeCreated), 0)) >= s.config.SentTTL {
+		return true
+	}
+	if s.config.CancelBeforeExpiry > 0 && offer.ExpirationTime != 0 && expiresAt(offer).Sub(now) <= s.config.CancelBeforeExpiry {
+		return true
+	}
+	return false
+}
+
+func (s *ExpiryScheduler) shouldWarn(offer *TradeOffer, now time.Time) bool {
+	return s.config.WarnBeforeExpiry > 0 && offer.ExpirationTime != 0 && expiresAt(offer).Sub(now) <= s.config.WarnBeforeExpiry
+}
+
+func expiresAt(offer *TradeOffer) time.Time {
+	return time.Unix(int64(offer.ExpirationTime), 0)
+}
@@ -0,0 +1,149 @@
+package tradeoffer
+
+import (
+	"strconv"
+
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+type TradeStatus uint
+
+const (
+	TradeStatus_Init                     TradeStatus = 0  // Trade has just been accepted/confirmed, but no work has been done yet
+	TradeStatus_PreCommitted             TradeStatus = 1  // Steam is about to start committing the trade
+	TradeStatus_Committed                TradeStatus = 2  // The items have been exchanged
+	TradeStatus_Complete                 TradeStatus = 3  // All work is finished
+	TradeStatus_Failed                   TradeStatus = 4  // Something went wrong after Init, but before Committed, and the trade has been rolled back
+	TradeStatus_PartialSupportRollback   TradeStatus = 5  // A support person rolled back the trade for one side
+	TradeStatus_FullSupportRollback      TradeStatus = 6  // A support person rolled back the trade for both sides
+	TradeStatus_SupportRollbackSelective TradeStatus = 7  // A support person rolled back the trade for some set of items
+	TradeStatus_RollbackFailed           TradeStatus = 8  // We tried to roll back the trade when it failed, but haven't managed to do that for all items yet
+	TradeStatus_RollbackAbandoned        TradeStatus = 9  // We tried to roll back the trade, but some failure didn't go away and we gave up
+	TradeStatus_InEscrow                 TradeStatus = 10 // Trade is in escrow
+	TradeStatus_EscrowRollback           TradeStatus = 11 // A trade in escrow was rolled back
+)
+
+// An asset that changed hands in a trade. After the trade completed the
+// item lives on under NewAssetId and NewContextId in the receiving inventory.
+type TradeAsset struct {
+	AppId        uint32 `json:"appid"`
+	ContextId    uint64 `json:"contextid,string"`
+	AssetId      uint64 `json:"assetid,string"`
+	CurrencyId   uint64 `json:"currencyid,string"`
+	Amount       uint64 `json:"amount,string"`
+	ClassId      uint64 `json:"classid,string"`
+	InstanceId   uint64 `json:"instanceid,string"`
+	NewAssetId   uint64 `json:"new_assetid,string"`
+	NewContextId uint64 `json:"new_contextid,string"`
+	// Only set for currencies.
+	NewCurrencyId uint64 `json:"new_currencyid,string"`
+}
+
+type Trade struct {
+	TradeId          uint64          `json:"tradeid,string"`
+	OtherSteamId     steamid.SteamId `json:"steamid_other,string"`
+	TimeInit         uint32          `json:"time_init"`
+	TimeEscrowEnd    uint32          `json:"time_escrow_end"`
+	Status           TradeStatus     `json:"status"`
+	AssetsGiven      []*TradeAsset   `json:"assets_given"`
+	AssetsReceived   []*TradeAsset   `json:"assets_received"`
+	CurrencyGiven    []*TradeAsset   `json:"currency_given"`
+	CurrencyReceived []*TradeAsset   `json:"currency_received"`
+}
+
+type TradeHistoryOptions struct {
+	// Number of trades per page, Steam allows up to 500.
+	MaxTrades         uint32
+	StartAfterTime    uint32
+	StartAfterTradeId uint64
+	// Page towards newer trades instead of older ones.
+	NavigatingBack  bool
+	GetDescriptions bool
+	IncludeFailed   bool
+	IncludeTotal    bool
+}
+
+// Returns the options for the page following res in the same direction,
+// keeping everything else.
+func (o TradeHistoryOptions) Next(res *TradeHistoryResult) TradeHistoryOptions {
+	if len(res.Trades) == 0 {
+		return o
+	}
+	// trades are always sorted newest first
+	edge := res.Trades[len(res.Trades)-1]
+	if o.NavigatingBack {
+		edge = res.Trades[0]
+	}
+	o.StartAfterTime = edge.TimeInit
+	o.StartAfterTradeId = edge.TradeId
+	return o
+}
+
+type TradeHistoryResult struct {
+	// Only set with IncludeTotal.
+	TotalTrades  uint32         `json:"total_trades"`
+	More         bool           `json:"more"`
+	Trades       []*Trade       `json:"trades"`
+	Descriptions []*Description `json:"descriptions"`
+}
+
+type TradeStatusResult struct {
+	Trades       []*Trade       `json:"trades"`
+	Descriptions []*Description `json:"descriptions"`
+}
+
+// Gets a page of completed trades, newest first. Use options.Next(result)
+// while result.More is set to walk the whole history.
+func (c *Client) GetTradeHistory(options TradeHistoryOptions) (*TradeHistoryResult, error) {
+	maxTrades := options.MaxTrades
+	if maxTrades == 0 {
+		maxTrades = 100
+	}
+	params := map[string]string{
+		"max_trades": strconv.FormatUint(uint64(maxTrades), 10),
+	}
+	if options.StartAfterTime != 0 {
+		params["start_after_time"] = strconv.FormatUint(uint64(options.StartAfterTime), 10)
+	}
+	if options.StartAfterTradeId != 0 {
+		params["start_after_tradeid"] = strconv.FormatUint(options.StartAfterTradeId, 10)
+	}
+	if options.NavigatingBack {
+		params["navigating_back"] = "1"
+	}
+	if options.GetDescriptions {
+		params["get_descriptions"] = "1"
+		params["language"] = "en_us"
+	}
+	if options.IncludeFailed {
+		params["include_failed"] = "1"
+	}
+	if options.IncludeTotal {
+		params["include_total"] = "1"
+	}
+	res := new(TradeHistoryResult)
+	if err := c.apiGet("GetTradeHistory", 1, params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Gets a single trade by the TradeId of an accepted offer. Unlike GetTradeReceipt
+// this also works for trades in escrow and reports the new asset ids.
+func (c *Client) GetTradeStatus(tradeId uint64, getDescriptions bool) (*TradeStatusResult, error) {
+	params := map[string]string{
+		"tradeid": strconv.FormatUint(tradeId, 10),
+	}
+	if getDescriptions {
+		params["get_descriptions"] = "1"
+		params["language"] = "en_us"
+	}
+	res := new(TradeStatusResult)
+	if err := c.apiGet("GetTradeStatus", 1, params, res); err != nil {
+		return nil, err
+	}
+	if len(res.Trades) == 0 {
+		return nil, newSteamErrorf("steam returned no trade for id %d\n", tradeId)
+	}
+	return res, nil
+}
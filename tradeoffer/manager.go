@@ -0,0 +1,307 @@
+package tradeoffer
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultPollInterval    = 30 * time.Second
+	defaultMinPollInterval = time.Second
+)
+
+type ManagerConfig struct {
+	// How often offers are polled. Defaults to 30 seconds.
+	PollInterval time.Duration
+	// Polls triggered by notifications are never closer together than this.
+	// Defaults to one second.
+	MinPollInterval time.Duration
+	// Whether GetTradeOffers should also return item descriptions.
+	GetDescriptions bool
+	// Where offer states and the poll cursor are kept. Defaults to an in-memory store.
+	Store OfferStore
+}
+
+// Manager polls trade offers and emits events when offers appear or change their state.
+// Always poll events from the channel returned by Events() or polling will stop.
+//
+// Call PollNow to poll as soon as Steam notifies about new trade offers,
+// steam.PollTradeOffersOnNotification does that for the events of a steam.Client.
+type Manager struct {
+	client *Client
+	config ManagerConfig
+
+	events  chan interface{}
+	trigger chan struct{}
+
+	mutex    sync.Mutex
+	stop     chan struct{}
+	done     chan struct{}
+	lastPoll time.Time
+	// held during a poll, so that a direct Poll doesn't race with the loop
+	pollMutex sync.Mutex
+}
+
+func NewManager(client *Client, config ManagerConfig) *Manager {
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.MinPollInterval <= 0 {
+		config.MinPollInterval = defaultMinPollInterval
+	}
+	if config.Store == nil {
+		config.Store = NewMemoryOfferStore()
+	}
+	return &Manager{
+		client:  client,
+		config:  config,
+		events:  make(chan interface{}, 3),
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// Get the event channel. All events are pointers, except for errors.
+// It is never closed.
+func (m *Manager) Events() <-chan interface{} {
+	return m.events
+}
+
+// Starts polling in a new goroutine. Calling Start on a running manager does nothing.
+func (m *Manager) Start() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+	go m.loop(m.stop, m.done)
+}
+
+// Stops polling and waits for the current poll to finish.
+func (m *Manager) Stop() {
+	m.mutex.Lock()
+	stop, done := m.stop, m.done
+	m.stop, m.done = nil, nil
+	m.mutex.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// Requests a poll as soon as MinPollInterval allows it.
+func (m *Manager) PollNow() {
+	select {
+	case m.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) loop(stop, done chan struct{}) {
+	defer close(done)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-timer.C:
+		case <-m.trigger:
+			m.mutex.Lock()
+			wait := m.config.MinPollInterval - time.Since(m.lastPoll)
+			m.mutex.Unlock()
+			if wait > 0 {
+				select {
+				case <-stop:
+					return
+				case <-time.After(wait):
+				}
+			}
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+
+		if err := m.poll(stop); err != nil {
+			m.emit(stop, err)
+		}
+		timer.Reset(m.config.PollInterval)
+	}
+}
+
+// Polls offers once and emits events for every change since the last poll.
+// It is called periodically by Start, but may also be called directly.
+func (m *Manager) Poll() error {
+	m.mutex.Lock()
+	stop := m.stop
+	m.mutex.Unlock()
+	return m.poll(stop)
+}
+
+func (m *Manager) poll(stop chan struct{}) error {
+	m.pollMutex.Lock()
+	defer m.pollMutex.Unlock()
+
+	m.mutex.Lock()
+	m.lastPoll = time.Now()
+	m.mutex.Unlock()
+
+	store := m.config.Store
+	cursor, err := store.Cursor()
+	if err != nil {
+		return err
+	}
+	// Active offers are always returned, historical ones only if they were
+	// updated after the cutoff. On the first poll there is no history we care about.
+	cutoff := uint32(time.Now().Unix())
+	if cursor > 0 {
+		cutoff = cursor - 1
+	}
+
+	res, err := m.client.GetOffers(true, true, m.config.GetDescriptions, true, false, &cutoff)
+	if err != nil {
+		return err
+	}
+
+	newCursor := cursor
+	for _, offers := range [][]*TradeOffer{res.Sent, res.Received} {
+		for _, offer := range offers {
+			if err := m.update(stop, offer); err != nil {
+				return err
+			}
+			if offer.TimeUpdated > newCursor {
+				newCursor = offer.TimeUpdated
+			}
+		}
+	}
+	if newCursor != cursor {
+		return store.SetCursor(newCursor)
+	}
+	return nil
+}
+
+func (m *Manager) update(stop chan struct{}, offer *TradeOffer) error {
+	store := m.config.Store
+	old, err := store.Get(offer.TradeOfferId)
+	if err != nil {
+		return err
+	}
+	record := &OfferRecord{
+		State:              offer.State,
+		ConfirmationMethod: offer.ConfirmationMethod,
+		TimeUpdated:        offer.TimeUpdated,
+	}
+	if old != nil && *old == *record {
+		return nil
+	}
+	if err := store.Put(offer.TradeOfferId, record); err != nil {
+		return err
+	}
+
+	if old == nil {
+		m.emit(stop, &NewOfferEvent{offer})
+	} else if old.State != offer.State {
+		m.emit(stop, &OfferChangedEvent{offer, old.State, offer.State})
+	}
+	if needsConfirmation(offer) && (old == nil || !needsConfirmation(&TradeOffer{State: old.State, ConfirmationMethod: old.ConfirmationMethod})) {
+		m.emit(stop, &OfferNeedsConfirmationEvent{offer})
+	}
+	return nil
+}
+
+// Offers we created wait in CreatedNeedsConfirmation, received offers we accepted
+// stay active with a confirmation method set.
+func needsConfirmation(offer *TradeOffer) bool {
+	if offer.State == TradeOfferState_CreatedNeedsConfirmation {
+		return true
+	}
+	return offer.State == TradeOfferState_Active && offer.ConfirmationMethod != TradeOfferConfirmationMethod_Invalid
+}
+
+// emit doesn't block forever if the manager is stopped while nobody reads events.
+func (m *Manager) emit(stop chan struct{}, event interface{}) {
+	if stop == nil {
+		m.events <- event
+		return
+	}
+	select {
+	case m.events <- event:
+	case <-stop:
+	}
+}
+
+// Fired for every offer the manager hasn't seen before, sent or received.
+type NewOfferEvent struct {
+	Offer *TradeOffer
+}
+
+// Fired when the state of a known offer changes.
+type OfferChangedEvent struct {
+	Offer *TradeOffer
+	Old   TradeOfferState
+	New   TradeOfferState
+}
+
+// Fired once when an offer starts waiting for an email or mobile confirmation.
+type OfferNeedsConfirmationEvent struct {
+	Offer *TradeOffer
+}
+
+// What the Manager remembers about an offer between polls.
+type OfferRecord struct {
+	State              TradeOfferState
+	ConfirmationMethod TradeOfferConfirmationMethod
+	TimeUpdated        uint32
+}
+
+// OfferStore persists offer states and the poll cursor of a Manager,
+// so that a restarted bot doesn't report known offers again.
+type OfferStore interface {
+	// Returns nil and no error for unknown offers.
+	Get(offerId uint64) (*OfferRecord, error)
+	Put(offerId uint64, record *OfferRecord) error
+	// The latest TimeUpdated seen, zero if nothing was polled yet.
+	Cursor() (uint32, error)
+	SetCursor(cursor uint32) error
+}
+
+type memoryOfferStore struct {
+	mutex  sync.RWMutex
+	offers map[uint64]OfferRecord
+	cursor uint32
+}
+
+func NewMemoryOfferStore() OfferStore {
+	return &memoryOfferStore{offers: make(map[uint64]OfferRecord)}
+}
+
+func (s *memoryOfferStore) Get(offerId uint64) (*OfferRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if record, ok := s.offers[offerId]; ok {
+		return &record, nil
+	}
+	return nil, nil
+}
+
+func (s *memoryOfferStore) Put(offerId uint64, record *OfferRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.offers[offerId] = *record
+	return nil
+}
+
+func (s *memoryOfferStore) Cursor() (uint32, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.cursor, nil
+}
+
+func (s *memoryOfferStore) SetCursor(cursor uint32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cursor = cursor
+	return nil
+}
@@ -37,7 +37,7 @@ const (
 )
 
 type Asset struct {
-	AppId      uint32 `json:"-"`
+	AppId      uint32 `json:"appid"`
 	ContextId  uint64 `json:",string"`
 	AssetId    uint64 `json:",string"`
 	CurrencyId uint64 `json:",string"`
@@ -45,6 +45,8 @@ type Asset struct {
 	InstanceId uint64 `json:",string"`
 	Amount     uint64 `json:",string"`
 	Missing    bool
+	// Set by AttachDescriptions, nil if Steam sent no description for the asset.
+	Description *Description `json:"-"`
 }
 
 type TradeOffer struct {
@@ -53,7 +55,7 @@ type TradeOffer struct {
 	OtherAccountId     uint32                       `json:"accountid_other"`
 	OtherSteamId       steamid.SteamId              `json:"-"`
 	Message            string                       `json:"message"`
-	ExpirationTime     uint32                       `json:"expiraton_time"`
+	ExpirationTime     uint32                       `json:"expiration_time"`
 	State              TradeOfferState              `json:"trade_offer_state"`
 	ToGive             []*Asset                     `json:"items_to_give"`
 	ToReceive          []*Asset                     `json:"items_to_receive"`
@@ -111,9 +113,20 @@ type Description struct {
 	Type string
 
 	Tradable                  bool   `json:"tradable"`
+	Marketable                bool   `json:"marketable"`
 	Commodity                 bool   `json:"commodity"`
 	MarketTradableRestriction uint32 `json:"market_tradable_restriction"`
 
 	Descriptions inventory.DescriptionLines `json:"descriptions"`
 	Actions      []*inventory.Action        `json:"actions"`
+	Tags         []*Tag                     `json:"tags"`
+}
+
+type Tag struct {
+	InternalName string `json:"internal_name"`
+	Name         string `json:"localized_tag_name"`
+	Category     string `json:"category"`
+	CategoryName string `json:"localized_category_name"`
+	// Color in hex, only set for some tags like rarity
+	Color string `json:"color"`
 }
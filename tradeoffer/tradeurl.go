@@ -0,0 +1,134 @@
+package tradeoffer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vuquang23/go-steam/netutil"
+	"github.com/vuquang23/go-steam/steamid"
+)
+
+var ErrInvalidTradeURL = errors.New("invalid trade url")
+
+const tradeURLBase = "https://steamcommunity.com/tradeoffer/new/"
+
+var (
+	tradeTokenRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	tradeURLRegex   = regexp.MustCompile(`https?://(?:www\.)?steamcommunity\.com/tradeoffer/new/?\?partner=\d+(?:&|&amp;)token=[A-Za-z0-9_-]+`)
+)
+
+// TradeURL is the link users share so that anyone can send them trade offers, like
+// https://steamcommunity.com/tradeoffer/new/?partner=123&token=abc
+type TradeURL struct {
+	Partner steamid.SteamId
+	// Empty for friends, who don't need a token.
+	Token string
+}
+
+// Parses and validates a trade URL.
+func ParseTradeURL(tradeURL string) (*TradeURL, error) {
+	u, err := url.Parse(strings.TrimSpace(tradeURL))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTradeURL, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("%w: unexpected scheme %q", ErrInvalidTradeURL, u.Scheme)
+	}
+	if u.Host != "steamcommunity.com" && u.Host != "www.steamcommunity.com" {
+		return nil, fmt.Errorf("%w: unexpected host %q", ErrInvalidTradeURL, u.Host)
+	}
+	if strings.TrimSuffix(u.Path, "/") != "/tradeoffer/new" {
+		return nil, fmt.Errorf("%w: unexpected path %q", ErrInvalidTradeURL, u.Path)
+	}
+
+	query := u.Query()
+	accountId, err := strconv.ParseUint(query.Get("partner"), 10, 32)
+	if err != nil || accountId == 0 {
+		return nil, fmt.Errorf("%w: invalid partner %q", ErrInvalidTradeURL, query.Get("partner"))
+	}
+	token := query.Get("token")
+	if token != "" && !tradeTokenRegex.MatchString(token) {
+		return nil, fmt.Errorf("%w: invalid token %q", ErrInvalidTradeURL, token)
+	}
+
+	return &TradeURL{
+		Partner: steamid.SteamId(accountId + 76561197960265728),
+		Token:   token,
+	}, nil
+}
+
+func (t *TradeURL) String() string {
+	values := url.Values{"partner": {strconv.FormatUint(uint64(t.Partner.GetAccountId()), 10)}}
+	if t.Token != "" {
+		values.Set("token", t.Token)
+	}
+	return tradeURLBase + "?" + values.Encode()
+}
+
+// Returns the token in the form expected by Create and GetPartnerEscrowDuration,
+// nil if there is none.
+func (t *TradeURL) AccessToken() *string {
+	if t.Token == "" {
+		return nil
+	}
+	token := t.Token
+	return &token
+}
+
+// Fetches our own trade URL from the trade offer privacy page.
+func (c *Client) GetTradeURL(me steamid.SteamId) (*TradeURL, error) {
+	req, err := http.NewRequest(http.MethodGet, "https://steamcommunity.com/profiles/"+me.ToString()+"/tradeoffers/privacy", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", defaultUserAgent)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	m := tradeURLRegex.Find(respBody)
+	if m == nil {
+		return nil, newSteamErrorf("trade url not found on privacy page")
+	}
+	return ParseTradeURL(strings.Replace(string(m), "&amp;", "&", 1))
+}
+
+// Invalidates our current trade URL token and returns the new trade URL.
+// Use this when a token has leaked.
+func (c *Client) RegenerateTradeURL(me steamid.SteamId) (*TradeURL, error) {
+	baseurl := "https://steamcommunity.com/profiles/" + me.ToString() + "/tradeoffers/"
+	req := netutil.NewPostForm(baseurl+"newtradeurl", netutil.ToUrlValues(map[string]string{
+		"sessionid": c.sessionId,
+	}))
+	req.Header.Add("Referer", baseurl+"privacy")
+	req.Header.Set("User-Agent", defaultUserAgent)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("regenerate trade url error: status code %d", resp.StatusCode)
+	}
+	// the response is the new token as a JSON string
+	var token string
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+	if !tradeTokenRegex.MatchString(token) {
+		return nil, newSteamErrorf("regenerate trade url error: unexpected token %q", token)
+	}
+	return &TradeURL{Partner: me, Token: token}, nil
+}
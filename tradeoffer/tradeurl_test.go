@@ -0,0 +1,35 @@
+package tradeoffer
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTradeURL(t *testing.T) {
+	u, err := ParseTradeURL("https://steamcommunity.com/tradeoffer/new/?partner=46143802&token=2bNh1tZ_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Partner != 76561198006409530 {
+		t.Fatalf("Invalid partner, expected 76561198006409530 and got %v", u.Partner.ToString())
+	}
+	if u.Token != "2bNh1tZ_" {
+		t.Fatalf("Invalid token, expected 2bNh1tZ_ and got %v", u.Token)
+	}
+	if s := u.String(); s != "https://steamcommunity.com/tradeoffer/new/?partner=46143802&token=2bNh1tZ_" {
+		t.Fatalf("Invalid trade url, got %v", s)
+	}
+}
+
+func TestParseTradeURLInvalid(t *testing.T) {
+	for _, s := range []string{
+		"https://example.com/tradeoffer/new/?partner=46143802&token=abc",
+		"https://steamcommunity.com/tradeoffer/new/?token=abc",
+		"https://steamcommunity.com/tradeoffer/new/?partner=46143802&token=a<b",
+		"https://steamcommunity.com/id/someone",
+	} {
+		if _, err := ParseTradeURL(s); !errors.Is(err, ErrInvalidTradeURL) {
+			t.Fatalf("Expected ErrInvalidTradeURL for %v, got %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package steam
+
+import (
+	"bytes"
+
+	"github.com/vuquang23/go-steam/protocol"
+	"github.com/vuquang23/go-steam/protocol/steamlang"
+	"github.com/vuquang23/go-steam/steamid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Writes a message as a new job. If handle is not nil, it's called with the
+// response packet, which has the same target job id as the returned job id.
+func (c *Client) writeJob(msg protocol.IMsg, handle func(*protocol.Packet)) protocol.JobId {
+	jobId := c.GetNextJobId()
+	c.writeJobWithId(jobId, msg, handle)
+	return jobId
+}
+
+// Like writeJob, for callers that need the job id before the message is sent
+func (c *Client) writeJobWithId(jobId protocol.JobId, msg protocol.IMsg, handle func(*protocol.Packet)) {
+	if handle != nil {
+		c.jobsMutex.Lock()
+		if c.jobs == nil {
+			c.jobs = make(map[protocol.JobId]func(*protocol.Packet))
+		}
+		c.jobs[jobId] = handle
+		c.jobsMutex.Unlock()
+	}
+	msg.SetSourceJobId(jobId)
+	c.Write(msg)
+}
+
+// Calls a method of a unified service, like "FriendMessages.SendMessage#1".
+// If handle is not nil, it's called with the response packet.
+func (c *Client) callServiceMethod(method string, body proto.Message, handle func(*protocol.Packet)) protocol.JobId {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ServiceMethodCallFromClient, body)
+	msg.Header.Proto.TargetJobName = proto.String(method)
+	return c.writeJob(msg, handle)
+}
+
+// Sends a notification to a unified service, which has no response.
+func (c *Client) notifyServiceMethod(method string, body proto.Message) {
+	c.callServiceMethod(method, body, nil)
+}
+
+// Calls the handler of the job the packet responds to, if any.
+func (c *Client) handleJobResponse(packet *protocol.Packet) {
+	c.jobsMutex.Lock()
+	handle, ok := c.jobs[packet.TargetJobId]
+	delete(c.jobs, packet.TargetJobId)
+	c.jobsMutex.Unlock()
+	if ok {
+		handle(packet)
+	}
+}
+
+// Drops the handlers of jobs that will never get a response.
+func (c *Client) clearJobs() {
+	c.jobsMutex.Lock()
+	defer c.jobsMutex.Unlock()
+	c.jobs = nil
+}
+
+// Returns the name of the service method an EMsg_ServiceMethod packet calls,
+// like "FriendMessagesClient.IncomingMessage#1".
+func serviceMethodName(packet *protocol.Packet) string {
+	header := steamlang.NewMsgHdrProtoBuf()
+	if header.Deserialize(bytes.NewReader(packet.Data)) != nil {
+		return ""
+	}
+	return header.Proto.GetTargetJobName()
+}
+
+// Returns the result of a service method response.
+func serviceMethodResult(msg *protocol.ClientMsgProtobuf) steamlang.EResult {
+	return steamlang.EResult(msg.Header.Proto.GetEresult())
+}
+
+// Unified services identify users and clans by their account id only.
+// Returns the SteamId of the account in our universe.
+func (c *Client) accountSteamId(accountId uint32, accountType steamlang.EAccountType) steamid.SteamId {
+	var instance uint32
+	if accountType == steamlang.EAccountType_Individual {
+		instance = 1
+	}
+	return steamid.NewIdAdv(accountId, instance, c.SteamId().GetAccountUniverse(), int32(accountType))
+}